@@ -0,0 +1 @@
+data "pterodactyl_database_hosts" "all" {}
\ No newline at end of file
@@ -0,0 +1,8 @@
+data "pterodactyl_nest" "minecraft" {
+  name = "Minecraft"
+}
+
+data "pterodactyl_egg" "paper" {
+  nest_id = data.pterodactyl_nest.minecraft.id
+  name    = "Paper"
+}
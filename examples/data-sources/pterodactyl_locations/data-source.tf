@@ -0,0 +1,29 @@
+data "pterodactyl_locations" "europe" {
+  short_prefix = "eu-"
+}
+
+# One node per European location
+resource "pterodactyl_node" "europe" {
+  for_each = { for location in data.pterodactyl_locations.europe.locations : location.short => location }
+
+  name                = "${each.key}-1"
+  description         = "Game servers in ${each.value.long}"
+  location_id         = each.value.id
+  public              = true
+  behind_proxy        = false
+  maintenance_mode    = false
+  fqdn                = "${each.key}-1.example.com"
+  scheme              = "https"
+  memory              = 32768
+  memory_overallocate = 0
+  disk                = 512000
+  disk_overallocate   = 0
+  upload_size         = 100
+  daemon_sftp         = 2022
+  daemon_listen       = 8080
+  allocations         = []
+}
+
+output "european_node_ids" {
+  value = { for location in data.pterodactyl_locations.europe.locations : location.short => location.node_ids }
+}
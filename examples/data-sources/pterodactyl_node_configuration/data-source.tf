@@ -0,0 +1,17 @@
+data "pterodactyl_node_configuration" "fra_1" {
+  node_id = pterodactyl_node.fra_1.id
+}
+
+# Provision Wings with its config.yml on the first boot
+resource "hcloud_server" "fra_1" {
+  name        = "fra-1"
+  image       = "debian-12"
+  server_type = "cpx41"
+  user_data = yamlencode({
+    write_files = [{
+      path        = "/etc/pterodactyl/config.yml"
+      permissions = "0600"
+      content     = data.pterodactyl_node_configuration.fra_1.config_yaml
+    }]
+  })
+}
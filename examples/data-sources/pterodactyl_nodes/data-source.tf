@@ -0,0 +1,8 @@
+data "pterodactyl_nodes" "frankfurt" {
+  location_id = 3
+  sort        = "-memory"
+}
+
+output "largest_frankfurt_node" {
+  value = data.pterodactyl_nodes.frankfurt.nodes[0].fqdn
+}
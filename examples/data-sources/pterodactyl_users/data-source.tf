@@ -1 +1,14 @@
-data "pterodactyl_users" "all" {}
\ No newline at end of file
+data "pterodactyl_users" "all" {}
+
+# The user linked to an account of the single sign-on provider
+data "pterodactyl_users" "sso" {
+  external_id = "sso-1"
+}
+
+data "pterodactyl_users" "newest_first" {
+  sort = "-id"
+}
+
+output "newest_username" {
+  value = data.pterodactyl_users.newest_first.users[0].username
+}
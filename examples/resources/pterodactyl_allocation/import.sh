@@ -0,0 +1 @@
+terraform import pterodactyl_allocation.example 2/10.0.0.2/25565-25600,27015
@@ -0,0 +1,6 @@
+resource "pterodactyl_allocation" "example" {
+  node_id = pterodactyl_node.example.id
+  ip      = "10.0.0.2"
+  ports   = ["25565-25600", "27015"]
+  alias   = "play.example.com"
+}
@@ -0,0 +1,3 @@
+# Database hosts can be imported by their ID, or by id: and name:
+terraform import pterodactyl_database_host.example 1
+terraform import pterodactyl_database_host.example name:fra-mysql
@@ -0,0 +1,8 @@
+resource "pterodactyl_database_host" "example" {
+  name     = "fra-mysql"
+  host     = "10.0.0.20"
+  port     = 3306
+  username = "pelican"
+  password = var.database_host_password
+  node_id  = pterodactyl_node.example.id
+}
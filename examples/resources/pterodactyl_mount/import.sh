@@ -0,0 +1,3 @@
+# Mounts can be imported by their ID, or by any of id:, uuid: and name:
+terraform import pterodactyl_mount.example 1
+terraform import pterodactyl_mount.example name:modpacks
@@ -0,0 +1,9 @@
+resource "pterodactyl_mount" "example" {
+  name        = "modpacks"
+  description = "Shared modpack archives"
+  source      = "/srv/modpacks"
+  target      = "/mnt/modpacks"
+  read_only   = true
+  node_ids    = [pterodactyl_node.example.id]
+  egg_ids     = [data.pterodactyl_egg.paper.id]
+}
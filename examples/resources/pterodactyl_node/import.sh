@@ -0,0 +1,3 @@
+# Nodes can be imported by their ID, or by any of id:, uuid: and name:
+terraform import pterodactyl_node.example 2
+terraform import pterodactyl_node.example name:fra-1
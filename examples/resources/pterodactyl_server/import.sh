@@ -0,0 +1,4 @@
+# Servers can be imported by their ID, or by any of
+# id:, uuid:, name: and external_id:
+terraform import pterodactyl_server.example 1
+terraform import pterodactyl_server.example name:minecraft
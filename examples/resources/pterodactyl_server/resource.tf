@@ -0,0 +1,28 @@
+resource "pterodactyl_server" "example" {
+  name          = "minecraft"
+  description   = "Our survival server"
+  user_id       = pterodactyl_user.example.id
+  allocation_id = 17
+  egg_id        = 1
+  docker_image  = "ghcr.io/pterodactyl/yolks:java_17"
+  startup       = "java -Xms128M -Xmx{{SERVER_MEMORY}}M -jar {{SERVER_JARFILE}}"
+
+  environment = {
+    SERVER_JARFILE = "server.jar"
+    BUILD_NUMBER   = "latest"
+  }
+
+  limits = {
+    memory = 4096
+    swap   = 0
+    disk   = 10240
+    io     = 500
+    cpu    = 200
+  }
+
+  feature_limits = {
+    databases   = 1
+    allocations = 1
+    backups     = 3
+  }
+}
@@ -0,0 +1 @@
+terraform import pterodactyl_server_database.example 9/3
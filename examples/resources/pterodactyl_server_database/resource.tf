@@ -0,0 +1,8 @@
+resource "pterodactyl_server_database" "example" {
+  server_id = pterodactyl_server.example.id
+  host_id   = 1
+  name      = "app"
+
+  # Change to generate a new password
+  rotate_password_trigger = "2024-05"
+}
@@ -1 +1,4 @@
-terraform import pterodactyl_user.example terraformer
\ No newline at end of file
+# Users can be imported by their username, or by any of
+# id:, username:, email:, external_id: and uuid:
+terraform import pterodactyl_user.example terraformer
+terraform import pterodactyl_user.example email:terra@form.de
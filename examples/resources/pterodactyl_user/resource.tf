@@ -4,3 +4,21 @@ resource "pterodactyl_user" "example" {
   first_name = "Terra"
   last_name  = "Former"
 }
+
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "pterodactyl_user" "admin" {
+  username   = "jdoe"
+  email      = "jdoe@form.de"
+  first_name = "Jane"
+  last_name  = "Doe"
+
+  root_admin       = true
+  language         = "en"
+  external_id      = "00u1a2b3c4D5e6F7g8h9"
+  password         = var.admin_password
+  password_version = "1"
+}
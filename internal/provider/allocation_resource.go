@@ -0,0 +1,645 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &allocationResource{}
+	_ resource.ResourceWithConfigure      = &allocationResource{}
+	_ resource.ResourceWithImportState    = &allocationResource{}
+	_ resource.ResourceWithValidateConfig = &allocationResource{}
+)
+
+// NewAllocationResource is a helper function to simplify the provider implementation.
+func NewAllocationResource() resource.Resource {
+	return &allocationResource{}
+}
+
+// allocationResource is the resource implementation.
+type allocationResource struct {
+	client *pterodactyl.Client
+}
+
+// allocationResourceModel maps the resource schema data.
+type allocationResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	NodeID        types.Int32    `tfsdk:"node_id"`
+	IP            types.String   `tfsdk:"ip"`
+	Ports         []types.String `tfsdk:"ports"`
+	Alias         types.String   `tfsdk:"alias"`
+	AllocationIDs types.Map      `tfsdk:"allocation_ids"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// allocationAPIFields maps the fields of panel validation errors to the allocation schema.
+var allocationAPIFields = rootAPIFields("ip", "alias", "ports")
+
+// Metadata returns the resource type name.
+func (r *allocationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allocation"
+}
+
+// Schema defines the schema for the resource.
+func (r *allocationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl allocation resource allows Terraform to manage a group of allocations of one IP on a node in the Pterodactyl Panel API. " +
+			"Leave the allocations attribute of the pterodactyl_node unset, it would otherwise remove these allocations.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the allocations in the format node_id/ip/ports.",
+				Computed:    true,
+			},
+			"node_id": schema.Int32Attribute{
+				Description: "The ID of the node the allocations are added to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Description: "The IP address of the allocations. The panel resolves host names, so use the IP address to avoid replacements.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ports": schema.ListAttribute{
+				Description: fmt.Sprintf("The ports of the allocations, single ports like \"25565\" or ranges like \"25565-25600\" of at most %d ports. Ports must be between %d and %d. Added and removed ports do not replace the other allocations, removing ports assigned to a server is refused.", allocationRangeSize, allocationPortMin, allocationPortMax),
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^\d+(-\d+)?$`), "must be a port or a range of ports like \"25565-25600\""),
+					),
+				},
+			},
+			"alias": schema.StringAttribute{
+				Description: "The alias shown to users instead of the IP address. The panel cannot change it afterwards, so changing it replaces the allocations.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allocation_ids": schema.MapAttribute{
+				Description: "The IDs of the allocations, keyed by their port.",
+				ElementType: types.Int32Type,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig checks the port ranges against the limits of the panel.
+func (r *allocationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ports types.List
+	diags := req.Config.GetAttribute(ctx, path.Root("ports"), &ports)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || ports.IsNull() || ports.IsUnknown() {
+		return
+	}
+
+	for i, port := range ports.Elements() {
+		port, ok := port.(types.String)
+		if !ok || port.IsNull() || port.IsUnknown() {
+			continue
+		}
+
+		_, err := expandPorts([]string{port.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ports").AtListIndex(i),
+				"Invalid Allocation Ports",
+				err.Error(),
+			)
+		}
+	}
+}
+
+// Create a new resource.
+func (r *allocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan allocationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	ports, err := expandPorts(plan.portRanges())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ports"),
+			"Invalid Allocation Ports",
+			err.Error(),
+		)
+		return
+	}
+
+	// Create all allocations with a single request
+	err = createNodeAllocations(ctx, r.client, plan.NodeID.ValueInt32(), apiPartialAllocation{
+		IP:    plan.IP.ValueString(),
+		Alias: plan.Alias.ValueStringPointer(),
+		Ports: plan.portRanges(),
+	})
+	if err != nil {
+		addAPIError(&resp.Diagnostics, allocationAPIFields, err,
+			"Error creating allocation",
+			contextErrorDetail(ctx, "creating the allocations", "Could not create allocations, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	// The panel does not return the created allocations, look them up
+	allocations, err := getNodeAllocations(ctx, r.client, plan.NodeID.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating allocation",
+			contextErrorDetail(ctx, "reading the node allocations", "Could not fetch node allocations, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	found := plan.fromAPI(allocations, ports)
+	if found != len(ports) {
+		resp.Diagnostics.AddError(
+			"Error creating allocation",
+			fmt.Sprintf("Created %d allocations, but only %d of them were found on node ID %d for IP %s. "+
+				"The panel stores the addresses host names resolve to, configure the IP address instead.",
+				len(ports), found, plan.NodeID.ValueInt32(), plan.IP.ValueString()),
+		)
+	}
+
+	// Set state to fully populated data, even when allocations are missing,
+	// so the found ones are not left behind untracked
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *allocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state allocationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	ports, err := expandPorts(state.portRanges())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pterodactyl Allocation",
+			"Could not parse the ports of allocations "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Get refreshed allocation values from Pterodactyl
+	allocations, err := getNodeAllocations(ctx, r.client, state.NodeID.ValueInt32())
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Pterodactyl node of the allocations not found, removing them from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pterodactyl Allocation",
+			contextErrorDetail(ctx, "reading the node allocations", "Could not read Pterodactyl allocations "+state.ID.ValueString()+": "+err.Error()),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	found := state.fromAPI(allocations, ports)
+	if found == 0 {
+		tflog.Warn(ctx, "Pterodactyl allocations not found, removing them from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update creates the added ports and deletes the removed ones, the IP and
+// alias cannot be changed by the panel and replace the allocations.
+func (r *allocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state allocationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	ports, err := expandPorts(plan.portRanges())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ports"),
+			"Invalid Allocation Ports",
+			err.Error(),
+		)
+		return
+	}
+
+	allocations, err := getNodeAllocations(ctx, r.client, plan.NodeID.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Pterodactyl Allocation",
+			contextErrorDetail(ctx, "reading the node allocations", "Could not fetch node allocations, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	existing := make(map[int32]apiAllocation)
+	for _, allocation := range allocations {
+		if allocation.IP == plan.IP.ValueString() {
+			existing[allocation.Port] = allocation
+		}
+	}
+
+	previous, err := expandPorts(state.portRanges())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Pterodactyl Allocation",
+			"Could not parse the ports of allocations "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	// Read narrows the ports of the state down to the ones in the panel, so
+	// ports deleted outside of Terraform are added again
+	added, removed := allocationPortChanges(previous, ports)
+
+	// Refuse the update before changing anything when a removed port is
+	// in use
+	removedAllocations := []apiAllocation{}
+	for _, port := range removed {
+		allocation, ok := existing[port]
+		if !ok {
+			continue
+		}
+		if allocation.Assigned {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ports"),
+				"Error Updating Pterodactyl Allocation",
+				"Could not delete allocation "+allocationKey(allocation.IP, allocation.Port)+": it is assigned to a server. "+
+					"Remove it from the server first or keep the port in the ports.",
+			)
+			continue
+		}
+		removedAllocations = append(removedAllocations, allocation)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, allocation := range removedAllocations {
+		// Stop early when the operation has been cancelled
+		if err := ctx.Err(); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Pterodactyl Allocation",
+				contextErrorDetail(ctx, "deleting allocation "+allocationKey(allocation.IP, allocation.Port), "Could not delete allocation: "+err.Error()),
+			)
+			return
+		}
+
+		err := deleteNodeAllocation(ctx, r.client, plan.NodeID.ValueInt32(), allocation.ID)
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Updating Pterodactyl Allocation",
+				contextErrorDetail(ctx, "deleting allocation "+allocationKey(allocation.IP, allocation.Port), "Could not delete allocation, unexpected error: "+err.Error()),
+			)
+			return
+		}
+	}
+
+	// Create all added allocations with a single request
+	if len(added) > 0 {
+		err = createNodeAllocations(ctx, r.client, plan.NodeID.ValueInt32(), apiPartialAllocation{
+			IP:    plan.IP.ValueString(),
+			Alias: plan.Alias.ValueStringPointer(),
+			Ports: compactPorts(added),
+		})
+		if err != nil {
+			addAPIError(&resp.Diagnostics, allocationAPIFields, err,
+				"Error Updating Pterodactyl Allocation",
+				contextErrorDetail(ctx, "creating the allocations", "Could not create allocations, unexpected error: "+err.Error()),
+			)
+			return
+		}
+
+		allocations, err = getNodeAllocations(ctx, r.client, plan.NodeID.ValueInt32())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Pterodactyl Allocation",
+				contextErrorDetail(ctx, "reading the node allocations", "Could not fetch node allocations, unexpected error: "+err.Error()),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	found := plan.fromAPI(allocations, ports)
+	if found != len(ports) {
+		resp.Diagnostics.AddError(
+			"Error Updating Pterodactyl Allocation",
+			fmt.Sprintf("Expected %d allocations, but only %d of them were found on node ID %d for IP %s.",
+				len(ports), found, plan.NodeID.ValueInt32(), plan.IP.ValueString()),
+		)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *allocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state allocationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	allocationIDs := make(map[string]types.Int32)
+	diags = state.AllocationIDs.ElementsAs(ctx, &allocationIDs, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the allocations one by one, the panel has no bulk deletion
+	ports := make([]string, 0, len(allocationIDs))
+	for port := range allocationIDs {
+		ports = append(ports, port)
+	}
+	slices.Sort(ports)
+
+	for _, port := range ports {
+		// Stop early when the operation has been cancelled
+		if err := ctx.Err(); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting Pterodactyl Allocation",
+				contextErrorDetail(ctx, "deleting allocation "+state.IP.ValueString()+":"+port, "Could not delete allocation: "+err.Error()),
+			)
+			return
+		}
+
+		err := deleteNodeAllocation(ctx, r.client, state.NodeID.ValueInt32(), allocationIDs[port].ValueInt32())
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Pterodactyl Allocation",
+				contextErrorDetail(ctx, "deleting allocation "+state.IP.ValueString()+":"+port, "Could not delete allocation, unexpected error: "+err.Error()),
+			)
+			return
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *allocationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Application
+}
+
+func (r *allocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Error importing state",
+			"Expected an id in the format node_id/ip/ports, e.g. 2/10.0.0.1/25565-25600,25700",
+		)
+		return
+	}
+
+	nodeID, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing state",
+			"Couldn't convert node_id to int",
+		)
+		return
+	}
+
+	state := allocationResourceModel{
+		NodeID: types.Int32Value(int32(nodeID)),
+		IP:     types.StringValue(parts[1]),
+	}
+	for _, port := range strings.Split(parts[2], ",") {
+		state.Ports = append(state.Ports, types.StringValue(port))
+	}
+
+	ports, err := expandPorts(state.portRanges())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing state",
+			"Couldn't parse ports: "+err.Error(),
+		)
+		return
+	}
+
+	allocations, err := getNodeAllocations(ctx, r.client, state.NodeID.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Pterodactyl Allocation",
+			"Could not import allocations: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	if state.fromAPI(allocations, ports) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Importing Pterodactyl Allocation",
+			"Could not import allocations: none of the ports are allocated on the node for the IP",
+		)
+		return
+	}
+
+	state.Timeouts = importTimeouts(ctx, resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// allocationPortChanges returns the ports to add and remove to get from the
+// previous ports to the planned ones.
+func allocationPortChanges(previous, planned []int32) (added, removed []int32) {
+	kept := make(map[int32]bool, len(previous))
+	for _, port := range previous {
+		kept[port] = false
+	}
+	for _, port := range planned {
+		if _, ok := kept[port]; !ok {
+			added = append(added, port)
+		}
+		kept[port] = true
+	}
+	for _, port := range previous {
+		if !kept[port] {
+			removed = append(removed, port)
+		}
+	}
+	return added, removed
+}
+
+// portRanges returns the configured ports and port ranges.
+func (m allocationResourceModel) portRanges() []string {
+	ranges := make([]string, len(m.Ports))
+	for i, port := range m.Ports {
+		ranges[i] = port.ValueString()
+	}
+	return ranges
+}
+
+// fromAPI maps the allocations of the node matching the IP and ports onto the
+// model and returns how many were found. When ports are missing, the ports
+// are replaced by the found ones, so the plan recreates the allocations.
+func (m *allocationResourceModel) fromAPI(allocations []apiAllocation, ports []int32) int {
+	wanted := make(map[int32]bool, len(ports))
+	for _, port := range ports {
+		wanted[port] = true
+	}
+
+	found := []int32{}
+	allocationIDs := make(map[string]attr.Value)
+	for _, allocation := range allocations {
+		if allocation.IP != m.IP.ValueString() || !wanted[allocation.Port] {
+			continue
+		}
+
+		// All allocations are created with the same alias
+		if len(found) == 0 {
+			m.Alias = types.StringPointerValue(allocation.Alias)
+		}
+
+		found = append(found, allocation.Port)
+		allocationIDs[strconv.Itoa(int(allocation.Port))] = types.Int32Value(allocation.ID)
+	}
+
+	slices.Sort(found)
+	if len(found) != len(ports) && len(found) > 0 {
+		m.Ports = nil
+		for _, port := range compactPorts(found) {
+			m.Ports = append(m.Ports, types.StringValue(port))
+		}
+	}
+
+	// The ID stays the same when ports go missing
+	if m.ID.IsNull() || m.ID.IsUnknown() {
+		m.ID = types.StringValue(fmt.Sprintf("%d/%s/%s", m.NodeID.ValueInt32(), m.IP.ValueString(), strings.Join(m.portRanges(), ",")))
+	}
+	m.AllocationIDs = types.MapValueMust(types.Int32Type, allocationIDs)
+
+	return len(found)
+}
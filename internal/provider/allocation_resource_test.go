@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAllocationResource(t *testing.T) {
+	panel := newFakePanel(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             panel.testAccCheckDestroyed,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(panel) + testAccAllocationResourceConfig("25565-25566"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pterodactyl_allocation.test", "allocation_ids.%", "2"),
+					resource.TestCheckResourceAttrSet("pterodactyl_allocation.test", "allocation_ids.25565"),
+					resource.TestCheckResourceAttrSet("pterodactyl_allocation.test", "allocation_ids.25566"),
+					// The allocations of the node are left unmanaged
+					resource.TestCheckNoResourceAttr("pterodactyl_node.test", "allocations.#"),
+				),
+			},
+			// Update and Read testing, the ports are changed in place
+			{
+				Config: testAccProviderConfig(panel) + testAccAllocationResourceConfig("25566", "25570"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("pterodactyl_allocation.test", "id", regexp.MustCompile(`/10\.0\.0\.1/25566,25570$`)),
+					resource.TestCheckResourceAttr("pterodactyl_allocation.test", "allocation_ids.%", "2"),
+					resource.TestCheckNoResourceAttr("pterodactyl_allocation.test", "allocation_ids.25565"),
+					resource.TestCheckResourceAttrSet("pterodactyl_allocation.test", "allocation_ids.25570"),
+					resource.TestCheckNoResourceAttr("pterodactyl_node.test", "allocations.#"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pterodactyl_allocation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAllocationResourceConfig(ports ...string) string {
+	return fmt.Sprintf(`
+resource "pterodactyl_location" "test" {
+  short = "test"
+  long  = "Test location"
+}
+
+resource "pterodactyl_node" "test" {
+  name                = "node-1"
+  description         = "Managed by Terraform"
+  public              = true
+  behind_proxy        = false
+  maintenance_mode    = false
+  location_id         = pterodactyl_location.test.id
+  fqdn                = "node1.example.com"
+  scheme              = "https"
+  memory              = 8192
+  memory_overallocate = 0
+  disk                = 102400
+  disk_overallocate   = 0
+  upload_size         = 100
+  daemon_sftp         = 2022
+  daemon_listen       = 8080
+}
+
+resource "pterodactyl_allocation" "test" {
+  node_id = pterodactyl_node.test.id
+  ip      = "10.0.0.1"
+  ports   = ["%s"]
+}
+`, strings.Join(ports, `", "`))
+}
+
+func TestAllocationResourceRead(t *testing.T) {
+	// Port 27018 was deleted outside of Terraform.
+	state := replayResourceRead(t, NewAllocationResource(), "allocation_resource_read", map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, "2/10.0.0.2/27015-27018"),
+		"node_id": tftypes.NewValue(tftypes.Number, 2),
+		"ip":      tftypes.NewValue(tftypes.String, "10.0.0.2"),
+		"ports": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "27015-27018"),
+		}),
+	})
+
+	var allocation allocationResourceModel
+	replayStateGet(t, state, &allocation)
+
+	replayCheck(t, "id", allocation.ID, types.StringValue("2/10.0.0.2/27015-27018"))
+	replayCheck(t, "alias", allocation.Alias, types.StringValue("game.example.com"))
+	replayCheck(t, "allocation_ids", allocation.AllocationIDs, types.MapValueMust(types.Int32Type, map[string]attr.Value{
+		"27015": types.Int32Value(21),
+		"27016": types.Int32Value(22),
+		"27017": types.Int32Value(23),
+	}))
+	if ports := allocation.portRanges(); !slices.Equal(ports, []string{"27015-27017"}) {
+		t.Errorf("ports is %v, expected [27015-27017]", ports)
+	}
+}
+
+func TestAllocationPortChanges(t *testing.T) {
+	added, removed := allocationPortChanges([]int32{25565, 25566, 25567}, []int32{25566, 25567, 25570, 25571})
+	if !slices.Equal(added, []int32{25570, 25571}) {
+		t.Errorf("added %v, expected [25570 25571]", added)
+	}
+	if !slices.Equal(removed, []int32{25565}) {
+		t.Errorf("removed %v, expected [25565]", removed)
+	}
+}
+
+func TestExpandPorts(t *testing.T) {
+	testCases := []struct {
+		ports     []string
+		expected  []int32
+		compacted []string
+		invalid   bool
+	}{
+		{ports: []string{"25565"}, expected: []int32{25565}, compacted: []string{"25565"}},
+		{ports: []string{"25567", "25565-25566"}, expected: []int32{25565, 25566, 25567}, compacted: []string{"25565-25567"}},
+		{ports: []string{"25565-25566", "25566", "25600"}, expected: []int32{25565, 25566, 25600}, compacted: []string{"25565-25566", "25600"}},
+		{ports: []string{"30000-30999"}, expected: nil, compacted: []string{"30000-30999"}},
+		{ports: []string{"30000-31000"}, invalid: true},
+		{ports: []string{"25566-25565"}, invalid: true},
+		{ports: []string{"1024"}, invalid: true},
+		{ports: []string{"65536"}, invalid: true},
+	}
+
+	for _, testCase := range testCases {
+		ports, err := expandPorts(testCase.ports)
+		if testCase.invalid {
+			if err == nil {
+				t.Errorf("%v: expected an error, got %v", testCase.ports, ports)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %s", testCase.ports, err)
+			continue
+		}
+		if testCase.expected != nil && !slices.Equal(ports, testCase.expected) {
+			t.Errorf("%v: expanded to %v, expected %v", testCase.ports, ports, testCase.expected)
+		}
+		if compacted := compactPorts(ports); !slices.Equal(compacted, testCase.compacted) {
+			t.Errorf("%v: compacted to %v, expected %v", testCase.ports, compacted, testCase.compacted)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	// applicationApiKeyPrefix is the prefix of application API keys.
+	applicationApiKeyPrefix = "ptla_"
+	// clientApiKeyPrefix is the prefix of client API keys.
+	clientApiKeyPrefix = "ptlc_"
+)
+
+// pterodactylClients holds the API clients the provider passes to the
+// resources and data sources.
+type pterodactylClients struct {
+	// Application is the client for the application API (/api/application).
+	Application *pterodactyl.Client
+	// Client is the client for the client API (/api/client), it is nil
+	// when no client API key has been configured.
+	Client *pterodactyl.Client
+}
+
+// clientAPI returns the client API client, adding an error diagnostic for
+// resourceType when no client API key has been configured.
+func (c *pterodactylClients) clientAPI(resourceType string, diags *diag.Diagnostics) *pterodactyl.Client {
+	if c.Client == nil {
+		diags.AddError(
+			"Missing Pterodactyl Panel Client API Key",
+			resourceType+" is managed through the Pterodactyl client API, which requires a client API key. "+
+				"Set the client_api_key value in the provider configuration or use the PTERODACTYL_CLIENT_API_KEY environment variable.",
+		)
+		return nil
+	}
+
+	return c.Client
+}
+
+// withContext returns a copy of the client whose requests are bound to ctx,
+// so the calls of pterodactyl-client-go, which do not take a context, are
+// cancelled together with the Terraform operation.
+func withContext(ctx context.Context, c *pterodactyl.Client) *pterodactyl.Client {
+	httpClient := *c.HTTPClient
+	httpClient.Transport = &contextTransport{
+		ctx:  ctx,
+		next: c.HTTPClient.Transport,
+	}
+
+	return &pterodactyl.Client{
+		HostURL:    c.HostURL,
+		HTTPClient: &httpClient,
+		Token:      c.Token,
+	}
+}
+
+// contextTransport is a http.RoundTripper replacing the context of every
+// request with its own.
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+// RoundTrip executes a single HTTP transaction bound to the context of the transport.
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return next.RoundTrip(req.WithContext(t.ctx))
+}
+
+// doRequest performs a request against the Pterodactyl Panel API for the
+// endpoints that are not (yet) implemented by pterodactyl-client-go.
+// It mirrors the behaviour of the client library so errors look the same.
+func doRequest(c *pterodactyl.Client, req *http.Request) ([]byte, error) {
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "Application/vnd.pterodactyl.v1+json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	statusOK := res.StatusCode >= 200 && res.StatusCode < 300
+	if !statusOK {
+		return nil, newAPIError(res.StatusCode, string(body))
+	}
+
+	return body, nil
+}
+
+// prepareBody marshals a request body to JSON.
+func prepareBody(body interface{}) io.Reader {
+	b, _ := json.Marshal(body)
+	return bytes.NewReader(b)
+}
+
+// apiListResponse - Single page of a list returned by the application API
+type apiListResponse[T any] struct {
+	Object string `json:"object"`
+	Data   []T    `json:"data"`
+	Meta   struct {
+		Pagination apiPagination `json:"pagination"`
+	} `json:"meta"`
+}
+
+// apiPagination - Pagination of a list returned by the application API
+type apiPagination struct {
+	Total       int32 `json:"total"`
+	Count       int32 `json:"count"`
+	PerPage     int32 `json:"per_page"`
+	CurrentPage int32 `json:"current_page"`
+	TotalPages  int32 `json:"total_pages"`
+}
+
+// apiPageSize is the number of items requested per page of lists, twice the
+// default of the panel to keep the number of requests low.
+const apiPageSize = 100
+
+// getAllPages requests every page of the list at endpoint and returns the
+// items of all of them.
+func getAllPages[T any](ctx context.Context, c *pterodactyl.Client, endpoint string) ([]T, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []T
+	for page := 1; ; page++ {
+		query := u.Query()
+		query.Set("page", strconv.Itoa(page))
+		query.Set("per_page", strconv.Itoa(apiPageSize))
+		u.RawQuery = query.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := doRequest(c, req)
+		if err != nil {
+			return nil, err
+		}
+
+		var response apiListResponse[T]
+		err = json.Unmarshal(body, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, response.Data...)
+
+		if int32(page) >= response.Meta.Pagination.TotalPages {
+			return items, nil
+		}
+	}
+}
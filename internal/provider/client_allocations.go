@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+)
+
+// apiAllocation - Allocation as returned by the application API
+type apiAllocation struct {
+	ID       int32   `json:"id"`
+	IP       string  `json:"ip"`
+	Alias    *string `json:"alias"`
+	Port     int32   `json:"port"`
+	Notes    *string `json:"notes"`
+	Assigned bool    `json:"assigned"`
+
+	Relationships struct {
+		Server struct {
+			Attributes *apiAllocationServer `json:"attributes"`
+		} `json:"server"`
+	} `json:"relationships"`
+}
+
+// apiAllocationServer - Server an allocation is assigned to
+type apiAllocationServer struct {
+	ID         int32  `json:"id"`
+	Identifier string `json:"identifier"`
+}
+
+// serverIdentifier returns the short identifier of the server the allocation
+// is assigned to, the client API addresses servers by it. It is empty for
+// unassigned allocations.
+func (a apiAllocation) serverIdentifier() string {
+	if a.Relationships.Server.Attributes == nil {
+		return ""
+	}
+	return a.Relationships.Server.Attributes.Identifier
+}
+
+type apiAllocationResponse struct {
+	Object     string        `json:"object"`
+	Attributes apiAllocation `json:"attributes"`
+}
+
+// apiPartialAllocation - Only used for creating allocations, ports are single
+// ports or ranges like "25565-25600"
+type apiPartialAllocation struct {
+	IP    string   `json:"ip"`
+	Alias *string  `json:"alias,omitempty"`
+	Ports []string `json:"ports"`
+}
+
+// getNodeAllocations - Returns list of allocations added to a node, with the
+// servers they are assigned to
+func getNodeAllocations(ctx context.Context, c *pterodactyl.Client, nodeID int32) ([]apiAllocation, error) {
+	responses, err := getAllPages[apiAllocationResponse](ctx, c, fmt.Sprintf("%s/api/application/nodes/%d/allocations?include=server", c.HostURL, nodeID))
+	if err != nil {
+		return nil, err
+	}
+
+	allocations := make([]apiAllocation, len(responses))
+	for i, response := range responses {
+		allocations[i] = response.Attributes
+	}
+
+	return allocations, nil
+}
+
+// createNodeAllocations - Adds allocations for one IP to a node, the panel
+// does not return the created allocations
+func createNodeAllocations(ctx context.Context, c *pterodactyl.Client, nodeID int32, allocation apiPartialAllocation) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/application/nodes/%d/allocations", c.HostURL, nodeID), prepareBody(allocation))
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(c, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deleteNodeAllocation - Deletes an allocation from a node, the panel refuses
+// to delete allocations assigned to a server
+func deleteNodeAllocation(ctx context.Context, c *pterodactyl.Client, nodeID int32, allocationID int32) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/application/nodes/%d/allocations/%d", c.HostURL, nodeID, allocationID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(c, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateServerAllocationNotes - Updates the notes of an allocation through the
+// client API, the application API cannot change allocations and the client
+// API only reaches those assigned to a server
+func updateServerAllocationNotes(ctx context.Context, c *pterodactyl.Client, serverIdentifier string, allocationID int32, notes *string) error {
+	body := map[string]*string{"notes": notes}
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/client/servers/%s/network/allocations/%d", c.HostURL, serverIdentifier, allocationID), prepareBody(body))
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(c, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Limits the panel applies to the ports of new allocations.
+const (
+	allocationPortMin   = 1025
+	allocationPortMax   = 65535
+	allocationRangeSize = 1000
+)
+
+// expandPorts returns the sorted, deduplicated ports of a list of single
+// ports and port ranges like "25565-25600".
+func expandPorts(ports []string) ([]int32, error) {
+	seen := make(map[int32]bool)
+	expanded := []int32{}
+	for _, port := range ports {
+		first, last, isRange := strings.Cut(port, "-")
+		if !isRange {
+			last = first
+		}
+
+		start, err := parsePort(first)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q: %w", port, err)
+		}
+		end, err := parsePort(last)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q: %w", port, err)
+		}
+		if end < start {
+			return nil, fmt.Errorf("invalid port range %q: the end is lower than the start", port)
+		}
+		if end-start >= allocationRangeSize {
+			return nil, fmt.Errorf("invalid port range %q: the panel allows at most %d ports per range", port, allocationRangeSize)
+		}
+
+		for p := start; p <= end; p++ {
+			if !seen[p] {
+				seen[p] = true
+				expanded = append(expanded, p)
+			}
+		}
+	}
+
+	sort.Slice(expanded, func(i, j int) bool { return expanded[i] < expanded[j] })
+
+	return expanded, nil
+}
+
+func parsePort(port string) (int32, error) {
+	p, err := strconv.ParseInt(strings.TrimSpace(port), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("not a number")
+	}
+	if p < allocationPortMin || p > allocationPortMax {
+		return 0, fmt.Errorf("ports must be between %d and %d", allocationPortMin, allocationPortMax)
+	}
+	return int32(p), nil
+}
+
+// compactPorts returns sorted ports as single ports and ranges of
+// consecutive ports, the reverse of expandPorts.
+func compactPorts(ports []int32) []string {
+	compacted := []string{}
+	for i := 0; i < len(ports); {
+		j := i
+		for j+1 < len(ports) && ports[j+1] == ports[j]+1 && ports[j+1]-ports[i] < allocationRangeSize {
+			j++
+		}
+		if i == j {
+			compacted = append(compacted, strconv.Itoa(int(ports[i])))
+		} else {
+			compacted = append(compacted, fmt.Sprintf("%d-%d", ports[i], ports[j]))
+		}
+		i = j + 1
+	}
+	return compacted
+}
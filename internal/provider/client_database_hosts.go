@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+)
+
+// Database hosts are only exposed by the application API of Pelican, the
+// Pterodactyl Panel 1.x manages them exclusively through its admin area and
+// answers the endpoints below with 404.
+
+// apiDatabaseHost - Database host as returned by the application API
+type apiDatabaseHost struct {
+	ID       int32  `json:"id"`
+	Name     string `json:"name"`
+	Host     string `json:"host"`
+	Port     int32  `json:"port"`
+	Username string `json:"username"`
+	// Node is the ID of the node the host is linked to, if any.
+	Node      *int32    `json:"node"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type apiDatabaseHostResponse struct {
+	Object     string          `json:"object"`
+	Attributes apiDatabaseHost `json:"attributes"`
+}
+
+// apiPartialDatabaseHost - Only used for creating and updating database hosts
+type apiPartialDatabaseHost struct {
+	Name     string `json:"name"`
+	Host     string `json:"host"`
+	Port     int32  `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	NodeID   *int32 `json:"node_id"`
+	// NodeIDs links the host to the node on Pelican, which supports
+	// multiple nodes per host.
+	NodeIDs []int32 `json:"node_ids"`
+}
+
+// getDatabaseHosts - Returns list of database hosts
+func getDatabaseHosts(ctx context.Context, c *pterodactyl.Client) ([]apiDatabaseHost, error) {
+	responses, err := getAllPages[apiDatabaseHostResponse](ctx, c, fmt.Sprintf("%s/api/application/database-hosts", c.HostURL))
+	if err != nil {
+		return nil, err
+	}
+
+	hosts := make([]apiDatabaseHost, len(responses))
+	for i, response := range responses {
+		hosts[i] = response.Attributes
+	}
+
+	return hosts, nil
+}
+
+// getDatabaseHost - Returns specific database host
+func getDatabaseHost(ctx context.Context, c *pterodactyl.Client, hostID int32) (apiDatabaseHost, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/application/database-hosts/%d", c.HostURL, hostID), nil)
+	if err != nil {
+		return apiDatabaseHost{}, err
+	}
+
+	return doDatabaseHostRequest(c, req)
+}
+
+// createDatabaseHost - Creates a new database host
+func createDatabaseHost(ctx context.Context, c *pterodactyl.Client, host apiPartialDatabaseHost) (apiDatabaseHost, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/application/database-hosts", c.HostURL), prepareBody(host))
+	if err != nil {
+		return apiDatabaseHost{}, err
+	}
+
+	return doDatabaseHostRequest(c, req)
+}
+
+// updateDatabaseHost - Updates a database host
+func updateDatabaseHost(ctx context.Context, c *pterodactyl.Client, hostID int32, host apiPartialDatabaseHost) (apiDatabaseHost, error) {
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/application/database-hosts/%d", c.HostURL, hostID), prepareBody(host))
+	if err != nil {
+		return apiDatabaseHost{}, err
+	}
+
+	return doDatabaseHostRequest(c, req)
+}
+
+// deleteDatabaseHost - Deletes a database host
+func deleteDatabaseHost(ctx context.Context, c *pterodactyl.Client, hostID int32) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/application/database-hosts/%d", c.HostURL, hostID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(c, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func doDatabaseHostRequest(c *pterodactyl.Client, req *http.Request) (apiDatabaseHost, error) {
+	body, err := doRequest(c, req)
+	if err != nil {
+		return apiDatabaseHost{}, err
+	}
+
+	var response apiDatabaseHostResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return apiDatabaseHost{}, err
+	}
+
+	return response.Attributes, nil
+}
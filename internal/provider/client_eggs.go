@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+)
+
+// apiNest - Nest as returned by the application API
+type apiNest struct {
+	ID          int32     `json:"id"`
+	UUID        string    `json:"uuid"`
+	Author      string    `json:"author"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type apiNestResponse struct {
+	Object     string  `json:"object"`
+	Attributes apiNest `json:"attributes"`
+}
+
+// apiEgg - Egg as returned by the application API, with its variables
+type apiEgg struct {
+	ID          int32  `json:"id"`
+	UUID        string `json:"uuid"`
+	Name        string `json:"name"`
+	Nest        int32  `json:"nest"`
+	Author      string `json:"author"`
+	Description string `json:"description"`
+	// DockerImages maps the display names of the images to the images.
+	DockerImages map[string]string `json:"docker_images"`
+	Config       apiEggConfig      `json:"config"`
+	Startup      string            `json:"startup"`
+	Script       apiEggScript      `json:"script"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+
+	Relationships struct {
+		Variables struct {
+			Data []apiEggVariableResponse `json:"data"`
+		} `json:"variables"`
+	} `json:"relationships"`
+}
+
+// apiEggConfig - Configuration of the servers created from an egg
+type apiEggConfig struct {
+	Files        json.RawMessage `json:"files"`
+	Startup      json.RawMessage `json:"startup"`
+	Stop         string          `json:"stop"`
+	Logs         json.RawMessage `json:"logs"`
+	FileDenylist []string        `json:"file_denylist"`
+	Extends      *int32          `json:"extends"`
+}
+
+// apiEggScript - Installation script of an egg
+type apiEggScript struct {
+	Privileged bool   `json:"privileged"`
+	Install    string `json:"install"`
+	Entry      string `json:"entry"`
+	Container  string `json:"container"`
+	Extends    *int32 `json:"extends"`
+}
+
+// apiEggVariable - Variable of an egg
+type apiEggVariable struct {
+	ID           int32     `json:"id"`
+	EggID        int32     `json:"egg_id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	EnvVariable  string    `json:"env_variable"`
+	DefaultValue string    `json:"default_value"`
+	UserViewable bool      `json:"user_viewable"`
+	UserEditable bool      `json:"user_editable"`
+	Rules        string    `json:"rules"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type apiEggResponse struct {
+	Object     string `json:"object"`
+	Attributes apiEgg `json:"attributes"`
+}
+
+type apiEggVariableResponse struct {
+	Object     string         `json:"object"`
+	Attributes apiEggVariable `json:"attributes"`
+}
+
+// variables returns the variables of the egg, they are only included when
+// requested with include=variables.
+func (e apiEgg) variables() []apiEggVariable {
+	variables := make([]apiEggVariable, len(e.Relationships.Variables.Data))
+	for i, variable := range e.Relationships.Variables.Data {
+		variables[i] = variable.Attributes
+	}
+	return variables
+}
+
+// getNests - Returns list of nests
+func getNests(ctx context.Context, c *pterodactyl.Client) ([]apiNest, error) {
+	responses, err := getAllPages[apiNestResponse](ctx, c, fmt.Sprintf("%s/api/application/nests", c.HostURL))
+	if err != nil {
+		return nil, err
+	}
+
+	nests := make([]apiNest, len(responses))
+	for i, response := range responses {
+		nests[i] = response.Attributes
+	}
+
+	return nests, nil
+}
+
+// getNest - Returns specific nest
+func getNest(ctx context.Context, c *pterodactyl.Client, nestID int32) (apiNest, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/application/nests/%d", c.HostURL, nestID), nil)
+	if err != nil {
+		return apiNest{}, err
+	}
+
+	body, err := doRequest(c, req)
+	if err != nil {
+		return apiNest{}, err
+	}
+
+	var response apiNestResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return apiNest{}, err
+	}
+
+	return response.Attributes, nil
+}
+
+// getNestEggs - Returns list of eggs of a nest, with their variables
+func getNestEggs(ctx context.Context, c *pterodactyl.Client, nestID int32) ([]apiEgg, error) {
+	responses, err := getAllPages[apiEggResponse](ctx, c, fmt.Sprintf("%s/api/application/nests/%d/eggs?include=variables", c.HostURL, nestID))
+	if err != nil {
+		return nil, err
+	}
+
+	eggs := make([]apiEgg, len(responses))
+	for i, response := range responses {
+		eggs[i] = response.Attributes
+	}
+
+	return eggs, nil
+}
+
+// getEgg - Returns specific egg of a nest, with its variables
+func getEgg(ctx context.Context, c *pterodactyl.Client, nestID int32, eggID int32) (apiEgg, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/application/nests/%d/eggs/%d?include=variables", c.HostURL, nestID, eggID), nil)
+	if err != nil {
+		return apiEgg{}, err
+	}
+
+	body, err := doRequest(c, req)
+	if err != nil {
+		return apiEgg{}, err
+	}
+
+	var response apiEggResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return apiEgg{}, err
+	}
+
+	return response.Attributes, nil
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+)
+
+// apiLocation - Location as returned by the application API, with its nodes
+// when requested with include=nodes
+type apiLocation struct {
+	pterodactyl.Location
+
+	Relationships struct {
+		Nodes struct {
+			Data []apiNodeResponse `json:"data"`
+		} `json:"nodes"`
+	} `json:"relationships"`
+}
+
+type apiLocationResponse struct {
+	Object     string      `json:"object"`
+	Attributes apiLocation `json:"attributes"`
+}
+
+// nodeIDs returns the IDs of the nodes of the location, they are only
+// included when requested with include=nodes.
+func (l apiLocation) nodeIDs() []int32 {
+	ids := make([]int32, len(l.Relationships.Nodes.Data))
+	for i, node := range l.Relationships.Nodes.Data {
+		ids[i] = node.Attributes.ID
+	}
+	return ids
+}
+
+// getLocations - Returns list of locations, query adds includes and filters
+// like filter[short]
+func getLocations(ctx context.Context, c *pterodactyl.Client, query url.Values) ([]apiLocation, error) {
+	endpoint := fmt.Sprintf("%s/api/application/locations", c.HostURL)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	responses, err := getAllPages[apiLocationResponse](ctx, c, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]apiLocation, len(responses))
+	for i, response := range responses {
+		locations[i] = response.Attributes
+	}
+
+	return locations, nil
+}
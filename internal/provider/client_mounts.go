@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+)
+
+// Mounts are only exposed by the application API of Pelican, the Pterodactyl
+// Panel 1.x manages them exclusively through its admin area and answers the
+// endpoints below with 404.
+
+// apiMount - Mount as returned by the application API, with its nodes and eggs
+type apiMount struct {
+	ID            int32  `json:"id"`
+	UUID          string `json:"uuid"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Source        string `json:"source"`
+	Target        string `json:"target"`
+	ReadOnly      bool   `json:"read_only"`
+	UserMountable bool   `json:"user_mountable"`
+
+	Relationships struct {
+		Nodes apiMountRelationship `json:"nodes"`
+		Eggs  apiMountRelationship `json:"eggs"`
+	} `json:"relationships"`
+}
+
+// apiMountRelationship - Nodes or eggs a mount is attached to, only their
+// IDs are of interest
+type apiMountRelationship struct {
+	Data []struct {
+		Attributes struct {
+			ID int32 `json:"id"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+// ids returns the IDs of the related objects.
+func (r apiMountRelationship) ids() []int32 {
+	ids := make([]int32, len(r.Data))
+	for i, object := range r.Data {
+		ids[i] = object.Attributes.ID
+	}
+	return ids
+}
+
+type apiMountResponse struct {
+	Object     string   `json:"object"`
+	Attributes apiMount `json:"attributes"`
+}
+
+// apiPartialMount - Only used for creating and updating mounts
+type apiPartialMount struct {
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Source        string `json:"source"`
+	Target        string `json:"target"`
+	ReadOnly      bool   `json:"read_only"`
+	UserMountable bool   `json:"user_mountable"`
+}
+
+// getMount - Returns specific mount, with its nodes and eggs
+func getMount(ctx context.Context, c *pterodactyl.Client, mountID int32) (apiMount, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/application/mounts/%d?include=nodes,eggs", c.HostURL, mountID), nil)
+	if err != nil {
+		return apiMount{}, err
+	}
+
+	return doMountRequest(c, req)
+}
+
+// createMount - Creates a new mount
+func createMount(ctx context.Context, c *pterodactyl.Client, mount apiPartialMount) (apiMount, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/application/mounts", c.HostURL), prepareBody(mount))
+	if err != nil {
+		return apiMount{}, err
+	}
+
+	return doMountRequest(c, req)
+}
+
+// updateMount - Updates a mount
+func updateMount(ctx context.Context, c *pterodactyl.Client, mountID int32, mount apiPartialMount) (apiMount, error) {
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/application/mounts/%d", c.HostURL, mountID), prepareBody(mount))
+	if err != nil {
+		return apiMount{}, err
+	}
+
+	return doMountRequest(c, req)
+}
+
+// deleteMount - Deletes a mount
+func deleteMount(ctx context.Context, c *pterodactyl.Client, mountID int32) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/application/mounts/%d", c.HostURL, mountID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(c, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// attachMount - Attaches a mount to nodes or eggs, relation being "nodes" or "eggs"
+func attachMount(ctx context.Context, c *pterodactyl.Client, mountID int32, relation string, ids []int32) error {
+	body := map[string][]int32{relation: ids}
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/application/mounts/%d/%s", c.HostURL, mountID, relation), prepareBody(body))
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(c, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// detachMount - Detaches a mount from a node or an egg, relation being "nodes" or "eggs"
+func detachMount(ctx context.Context, c *pterodactyl.Client, mountID int32, relation string, id int32) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/application/mounts/%d/%s/%d", c.HostURL, mountID, relation, id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(c, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func doMountRequest(c *pterodactyl.Client, req *http.Request) (apiMount, error) {
+	body, err := doRequest(c, req)
+	if err != nil {
+		return apiMount{}, err
+	}
+
+	var response apiMountResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return apiMount{}, err
+	}
+
+	return response.Attributes, nil
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"gopkg.in/yaml.v3"
+)
+
+type apiNodeResponse struct {
+	Object     string           `json:"object"`
+	Attributes pterodactyl.Node `json:"attributes"`
+}
+
+// apiNodeConfiguration - Wings configuration of a node. Unlike every other
+// endpoint, the panel returns it without the object wrapper that the
+// GetNodeConfiguration of pterodactyl-client-go expects.
+type apiNodeConfiguration struct {
+	Debug   bool   `json:"debug"`
+	UUID    string `json:"uuid"`
+	TokenID string `json:"token_id"`
+	Token   string `json:"token"`
+	API     struct {
+		Host string `json:"host"`
+		Port int32  `json:"port"`
+		SSL  struct {
+			Enabled bool   `json:"enabled"`
+			Cert    string `json:"cert"`
+			Key     string `json:"key"`
+		} `json:"ssl"`
+		UploadLimit int32 `json:"upload_limit"`
+	} `json:"api"`
+	System struct {
+		Data string `json:"data"`
+		SFTP struct {
+			BindPort int32 `json:"bind_port"`
+		} `json:"sftp"`
+	} `json:"system"`
+	AllowedMounts []string `json:"allowed_mounts"`
+	Remote        string   `json:"remote"`
+
+	// YAML is the configuration rendered as the config.yml of Wings, with
+	// every setting returned by the panel in the order it returned them.
+	YAML string `json:"-"`
+}
+
+// getNodes - Returns list of nodes, query adds filters like filter[name] and
+// the sort order
+func getNodes(ctx context.Context, c *pterodactyl.Client, query url.Values) ([]pterodactyl.Node, error) {
+	endpoint := fmt.Sprintf("%s/api/application/nodes", c.HostURL)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	responses, err := getAllPages[apiNodeResponse](ctx, c, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]pterodactyl.Node, len(responses))
+	for i, response := range responses {
+		nodes[i] = response.Attributes
+	}
+
+	return nodes, nil
+}
+
+// getNodeConfiguration - Returns the Wings configuration of a node
+func getNodeConfiguration(ctx context.Context, c *pterodactyl.Client, nodeID int32) (apiNodeConfiguration, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/application/nodes/%d/configuration", c.HostURL, nodeID), nil)
+	if err != nil {
+		return apiNodeConfiguration{}, err
+	}
+
+	body, err := doRequest(c, req)
+	if err != nil {
+		return apiNodeConfiguration{}, err
+	}
+
+	var configuration apiNodeConfiguration
+	err = json.Unmarshal(body, &configuration)
+	if err != nil {
+		return apiNodeConfiguration{}, err
+	}
+
+	configuration.YAML, err = renderYAML(body)
+	if err != nil {
+		return apiNodeConfiguration{}, err
+	}
+
+	return configuration, nil
+}
+
+// renderYAML renders a JSON document as block style YAML, keeping the order
+// of its keys.
+func renderYAML(document []byte) (string, error) {
+	// JSON is a subset of YAML, so the document parses into YAML nodes
+	// which remember the order of the keys, unlike maps.
+	var node yaml.Node
+	if err := yaml.Unmarshal(document, &node); err != nil {
+		return "", err
+	}
+	resetYAMLStyle(&node)
+
+	var b bytes.Buffer
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// resetYAMLStyle drops the JSON flow style and quoting of node and its
+// children, so they are rendered in the default style of YAML.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
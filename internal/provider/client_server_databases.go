@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+)
+
+// apiServerDatabase - Database of a server as returned by the application API
+type apiServerDatabase struct {
+	ID     int32 `json:"id"`
+	Server int32 `json:"server"`
+	Host   int32 `json:"host"`
+	// Database is the name of the database, prefixed with s<server id>_ by
+	// the panel.
+	Database       string    `json:"database"`
+	Username       string    `json:"username"`
+	Remote         string    `json:"remote"`
+	MaxConnections *int32    `json:"max_connections"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+
+	Relationships struct {
+		Password struct {
+			Attributes struct {
+				Password string `json:"password"`
+			} `json:"attributes"`
+		} `json:"password"`
+		Host struct {
+			Attributes apiDatabaseHost `json:"attributes"`
+		} `json:"host"`
+	} `json:"relationships"`
+}
+
+type apiServerDatabaseResponse struct {
+	Object     string            `json:"object"`
+	Attributes apiServerDatabase `json:"attributes"`
+}
+
+// apiCreateServerDatabase - Only used for creating a new server database
+type apiCreateServerDatabase struct {
+	Database string `json:"database"`
+	Remote   string `json:"remote"`
+	Host     int32  `json:"host"`
+}
+
+// getServerDatabase - Returns specific database of a server, with its
+// password and host
+func getServerDatabase(ctx context.Context, c *pterodactyl.Client, serverID int32, databaseID int32) (apiServerDatabase, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/application/servers/%d/databases/%d?include=password,host", c.HostURL, serverID, databaseID), nil)
+	if err != nil {
+		return apiServerDatabase{}, err
+	}
+
+	body, err := doRequest(c, req)
+	if err != nil {
+		return apiServerDatabase{}, err
+	}
+
+	var response apiServerDatabaseResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return apiServerDatabase{}, err
+	}
+
+	return response.Attributes, nil
+}
+
+// createServerDatabase - Creates a new database for a server, returning its ID
+func createServerDatabase(ctx context.Context, c *pterodactyl.Client, serverID int32, database apiCreateServerDatabase) (int32, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/application/servers/%d/databases", c.HostURL, serverID), prepareBody(database))
+	if err != nil {
+		return 0, err
+	}
+
+	body, err := doRequest(c, req)
+	if err != nil {
+		return 0, err
+	}
+
+	var response apiServerDatabaseResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Attributes.ID, nil
+}
+
+// resetServerDatabasePassword - Generates a new password for a server database
+func resetServerDatabasePassword(ctx context.Context, c *pterodactyl.Client, serverID int32, databaseID int32) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/application/servers/%d/databases/%d/reset-password", c.HostURL, serverID, databaseID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(c, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deleteServerDatabase - Deletes a database of a server
+func deleteServerDatabase(ctx context.Context, c *pterodactyl.Client, serverID int32, databaseID int32) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/application/servers/%d/databases/%d", c.HostURL, serverID, databaseID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(c, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+)
+
+// apiServer - Server as returned by the application API
+type apiServer struct {
+	ID            int32                  `json:"id"`
+	ExternalID    *string                `json:"external_id"`
+	UUID          string                 `json:"uuid"`
+	Identifier    string                 `json:"identifier"`
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	Suspended     bool                   `json:"suspended"`
+	Limits        apiServerLimits        `json:"limits"`
+	FeatureLimits apiServerFeatureLimits `json:"feature_limits"`
+	User          int32                  `json:"user"`
+	Node          int32                  `json:"node"`
+	Allocation    int32                  `json:"allocation"`
+	Nest          int32                  `json:"nest"`
+	Egg           int32                  `json:"egg"`
+	Container     apiServerContainer     `json:"container"`
+	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+}
+
+// apiServerLimits - Resource limits of a server
+type apiServerLimits struct {
+	Memory      int32   `json:"memory"`
+	Swap        int32   `json:"swap"`
+	Disk        int32   `json:"disk"`
+	IO          int32   `json:"io"`
+	CPU         int32   `json:"cpu"`
+	Threads     *string `json:"threads"`
+	OOMDisabled bool    `json:"oom_disabled"`
+}
+
+// apiServerFeatureLimits - Feature limits of a server
+type apiServerFeatureLimits struct {
+	Databases   int32 `json:"databases"`
+	Allocations int32 `json:"allocations"`
+	Backups     int32 `json:"backups"`
+}
+
+// apiServerContainer - Container settings of a server
+type apiServerContainer struct {
+	StartupCommand string         `json:"startup_command"`
+	Image          string         `json:"image"`
+	Installed      int32          `json:"installed"`
+	Environment    apiEnvironment `json:"environment"`
+}
+
+// apiEnvironment - Environment variables of a server. The panel returns the
+// variables it injects itself, like P_SERVER_ALLOCATION_LIMIT, as numbers and
+// unset variables as null.
+type apiEnvironment map[string]string
+
+func (e *apiEnvironment) UnmarshalJSON(b []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var raw map[string]interface{}
+	if err := decoder.Decode(&raw); err != nil {
+		return err
+	}
+
+	*e = make(apiEnvironment, len(raw))
+	for key, value := range raw {
+		switch value := value.(type) {
+		case nil:
+			(*e)[key] = ""
+		case string:
+			(*e)[key] = value
+		default:
+			(*e)[key] = fmt.Sprint(value)
+		}
+	}
+
+	return nil
+}
+
+type apiServerResponse struct {
+	Object     string    `json:"object"`
+	Attributes apiServer `json:"attributes"`
+}
+
+// apiServerAllocation - Allocations assigned on server creation
+type apiServerAllocation struct {
+	Default    int32   `json:"default"`
+	Additional []int32 `json:"additional,omitempty"`
+}
+
+// apiCreateServer - Only used for creating a new server
+type apiCreateServer struct {
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	ExternalID    *string                `json:"external_id,omitempty"`
+	User          int32                  `json:"user"`
+	Egg           int32                  `json:"egg"`
+	DockerImage   string                 `json:"docker_image"`
+	Startup       string                 `json:"startup"`
+	Environment   map[string]string      `json:"environment"`
+	Limits        apiServerLimits        `json:"limits"`
+	FeatureLimits apiServerFeatureLimits `json:"feature_limits"`
+	Allocation    apiServerAllocation    `json:"allocation"`
+}
+
+// apiServerDetails - Body of the server details endpoint
+type apiServerDetails struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	ExternalID  *string `json:"external_id"`
+	User        int32   `json:"user"`
+}
+
+// apiServerBuild - Body of the server build endpoint
+type apiServerBuild struct {
+	Allocation    int32                  `json:"allocation"`
+	OOMDisabled   bool                   `json:"oom_disabled"`
+	Limits        apiServerLimits        `json:"limits"`
+	FeatureLimits apiServerFeatureLimits `json:"feature_limits"`
+	// AddAllocations are the IDs of allocations to assign to the server.
+	AddAllocations []int32 `json:"add_allocations,omitempty"`
+	// RemoveAllocations are the IDs of allocations to take from the server,
+	// the default allocation can only be taken when another one is added.
+	RemoveAllocations []int32 `json:"remove_allocations,omitempty"`
+}
+
+// apiServerStartup - Body of the server startup endpoint
+type apiServerStartup struct {
+	Startup     string            `json:"startup"`
+	Environment map[string]string `json:"environment"`
+	Egg         int32             `json:"egg"`
+	Image       string            `json:"image"`
+	SkipScripts bool              `json:"skip_scripts"`
+}
+
+// getServer - Returns specific server
+func getServer(ctx context.Context, c *pterodactyl.Client, serverID int32) (apiServer, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/application/servers/%d", c.HostURL, serverID), nil)
+	if err != nil {
+		return apiServer{}, err
+	}
+
+	return doServerRequest(c, req)
+}
+
+// createServer - Creates a new server
+func createServer(ctx context.Context, c *pterodactyl.Client, server apiCreateServer) (apiServer, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/application/servers", c.HostURL), prepareBody(server))
+	if err != nil {
+		return apiServer{}, err
+	}
+
+	return doServerRequest(c, req)
+}
+
+// updateServerDetails - Updates the details of a server
+func updateServerDetails(ctx context.Context, c *pterodactyl.Client, serverID int32, details apiServerDetails) (apiServer, error) {
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/application/servers/%d/details", c.HostURL, serverID), prepareBody(details))
+	if err != nil {
+		return apiServer{}, err
+	}
+
+	return doServerRequest(c, req)
+}
+
+// updateServerBuild - Updates the build configuration of a server
+func updateServerBuild(ctx context.Context, c *pterodactyl.Client, serverID int32, build apiServerBuild) (apiServer, error) {
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/application/servers/%d/build", c.HostURL, serverID), prepareBody(build))
+	if err != nil {
+		return apiServer{}, err
+	}
+
+	return doServerRequest(c, req)
+}
+
+// updateServerStartup - Updates the startup configuration of a server
+func updateServerStartup(ctx context.Context, c *pterodactyl.Client, serverID int32, startup apiServerStartup) (apiServer, error) {
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/application/servers/%d/startup", c.HostURL, serverID), prepareBody(startup))
+	if err != nil {
+		return apiServer{}, err
+	}
+
+	return doServerRequest(c, req)
+}
+
+// deleteServer - Deletes a server
+func deleteServer(ctx context.Context, c *pterodactyl.Client, serverID int32) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/application/servers/%d", c.HostURL, serverID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(c, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func doServerRequest(c *pterodactyl.Client, req *http.Request) (apiServer, error) {
+	body, err := doRequest(c, req)
+	if err != nil {
+		return apiServer{}, err
+	}
+
+	var response apiServerResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return apiServer{}, err
+	}
+
+	return response.Attributes, nil
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAPIEnvironmentUnmarshalJSON(t *testing.T) {
+	var container apiServerContainer
+	err := json.Unmarshal([]byte(`{
+		"startup_command": "java -jar {{SERVER_JARFILE}}",
+		"image": "ghcr.io/pterodactyl/yolks:java_17",
+		"installed": 1,
+		"environment": {
+			"SERVER_JARFILE": "server.jar",
+			"BUILD_NUMBER": null,
+			"P_SERVER_ALLOCATION_LIMIT": 0,
+			"P_SERVER_MEMORY": 4096
+		}
+	}`), &container)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]string{
+		"SERVER_JARFILE":            "server.jar",
+		"BUILD_NUMBER":              "",
+		"P_SERVER_ALLOCATION_LIMIT": "0",
+		"P_SERVER_MEMORY":           "4096",
+	}
+	if len(container.Environment) != len(want) {
+		t.Fatalf("environment has %d variables, expected %d: %v", len(container.Environment), len(want), container.Environment)
+	}
+	for key, value := range want {
+		if got, ok := container.Environment[key]; !ok || got != value {
+			t.Errorf("environment.%s is %q, expected %q", key, got, value)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+)
+
+// apiPartialUser - Only used for creating and updating users, the
+// PartialUser of pterodactyl-client-go lacks everything but the names
+type apiPartialUser struct {
+	ExternalID *string `json:"external_id"`
+	Username   string  `json:"username"`
+	Email      string  `json:"email"`
+	FirstName  string  `json:"first_name"`
+	LastName   string  `json:"last_name"`
+	Language   string  `json:"language,omitempty"`
+	RootAdmin  bool    `json:"root_admin"`
+	// Password is left out to keep the current password, new users without
+	// one receive an email to set it.
+	Password string `json:"password,omitempty"`
+}
+
+type apiUserResponse struct {
+	Object     string           `json:"object"`
+	Attributes pterodactyl.User `json:"attributes"`
+}
+
+// getUsers - Returns list of users, query adds filters like filter[email] and
+// the sort order
+func getUsers(ctx context.Context, c *pterodactyl.Client, query url.Values) ([]pterodactyl.User, error) {
+	endpoint := fmt.Sprintf("%s/api/application/users", c.HostURL)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	responses, err := getAllPages[apiUserResponse](ctx, c, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]pterodactyl.User, len(responses))
+	for i, response := range responses {
+		users[i] = response.Attributes
+	}
+
+	return users, nil
+}
+
+// createUser - Creates a new user
+func createUser(ctx context.Context, c *pterodactyl.Client, user apiPartialUser) (pterodactyl.User, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/application/users", c.HostURL), prepareBody(user))
+	if err != nil {
+		return pterodactyl.User{}, err
+	}
+
+	return doUserRequest(c, req)
+}
+
+// updateUser - Updates a user
+func updateUser(ctx context.Context, c *pterodactyl.Client, userID int32, user apiPartialUser) (pterodactyl.User, error) {
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/application/users/%d", c.HostURL, userID), prepareBody(user))
+	if err != nil {
+		return pterodactyl.User{}, err
+	}
+
+	return doUserRequest(c, req)
+}
+
+func doUserRequest(c *pterodactyl.Client, req *http.Request) (pterodactyl.User, error) {
+	body, err := doRequest(c, req)
+	if err != nil {
+		return pterodactyl.User{}, err
+	}
+
+	var response apiUserResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return pterodactyl.User{}, err
+	}
+
+	return response.Attributes, nil
+}
@@ -0,0 +1,385 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &databaseHostResource{}
+	_ resource.ResourceWithConfigure   = &databaseHostResource{}
+	_ resource.ResourceWithImportState = &databaseHostResource{}
+)
+
+// NewDatabaseHostResource is a helper function to simplify the provider implementation.
+func NewDatabaseHostResource() resource.Resource {
+	return &databaseHostResource{}
+}
+
+// databaseHostResource is the resource implementation.
+type databaseHostResource struct {
+	client *pterodactyl.Client
+}
+
+// databaseHostResourceModel maps the resource schema data.
+type databaseHostResourceModel struct {
+	ID        types.Int32    `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	Host      types.String   `tfsdk:"host"`
+	Port      types.Int32    `tfsdk:"port"`
+	Username  types.String   `tfsdk:"username"`
+	Password  types.String   `tfsdk:"password"`
+	NodeID    types.Int32    `tfsdk:"node_id"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	UpdatedAt types.String   `tfsdk:"updated_at"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// databaseHostAPIFields maps the fields of panel validation errors to the database host schema.
+var databaseHostAPIFields = apiFieldPaths{
+	"name":     path.Root("name"),
+	"host":     path.Root("host"),
+	"port":     path.Root("port"),
+	"username": path.Root("username"),
+	"password": path.Root("password"),
+	"node_id":  path.Root("node_id"),
+	"node_ids": path.Root("node_id"),
+}
+
+// databaseHostImportIDs are the import IDs of database hosts.
+var databaseHostImportIDs = importIDLookup{
+	Object:     "database host",
+	Endpoint:   "/api/application/database-hosts",
+	DefaultKey: "id",
+	Keys:       []string{"name"},
+}
+
+// Metadata returns the resource type name.
+func (r *databaseHostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_host"
+}
+
+// Schema defines the schema for the resource.
+func (r *databaseHostResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl database host resource allows Terraform to manage database hosts in the Pterodactyl Panel API. " +
+			"Database hosts can only be managed through the API of Pelican, the Pterodactyl Panel 1.x does not expose them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				Description: "The ID of the database host.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the database host.",
+				Required:    true,
+			},
+			"host": schema.StringAttribute{
+				Description: "The IP address or domain name the panel connects to the database host with.",
+				Required:    true,
+			},
+			"port": schema.Int32Attribute{
+				Description: "The port of the database host.",
+				Required:    true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the account the panel creates databases with.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the account the panel creates databases with. The panel never returns it, so changes made outside of Terraform are not detected.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"node_id": schema.Int32Attribute{
+				Description: "The ID of the node the database host is linked to. Servers on the node default to this host.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The creation date of the database host.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The last update date of the database host.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *databaseHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan databaseHostResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new database host
+	host, err := createDatabaseHost(ctx, r.client, plan.toAPI())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, databaseHostAPIFields, err,
+			"Error creating database host",
+			contextErrorDetail(ctx, "creating the database host", "Could not create database host, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.fromAPI(host)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *databaseHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state databaseHostResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed database host value from Pterodactyl
+	host, err := getDatabaseHost(ctx, r.client, state.ID.ValueInt32())
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Pterodactyl database host not found, removing it from state", map[string]interface{}{
+			"id": state.ID.ValueInt32(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pterodactyl Database Host",
+			contextErrorDetail(ctx, "reading the database host", "Could not read Pterodactyl database host ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error()),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.fromAPI(host)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *databaseHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan databaseHostResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing database host
+	host, err := updateDatabaseHost(ctx, r.client, plan.ID.ValueInt32(), plan.toAPI())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, databaseHostAPIFields, err,
+			"Error Updating Pterodactyl Database Host",
+			contextErrorDetail(ctx, "updating the database host", "Could not update database host, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	// Update resource state with updated values
+	plan.fromAPI(host)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *databaseHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state databaseHostResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing database host
+	err := deleteDatabaseHost(ctx, r.client, state.ID.ValueInt32())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Pterodactyl Database Host",
+			contextErrorDetail(ctx, "deleting the database host", "Could not delete database host, unexpected error: "+err.Error()),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *databaseHostResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Application
+}
+
+func (r *databaseHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostID, ok := databaseHostImportIDs.resolve(ctx, r.client, &resp.Diagnostics, req.ID)
+	if !ok {
+		return
+	}
+
+	host, err := getDatabaseHost(ctx, r.client, hostID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Pterodactyl Database Host",
+			"Could not import database host: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values,
+	// the password is not returned by the panel and stays empty
+	var state databaseHostResourceModel
+	state.fromAPI(host)
+
+	state.Timeouts = importTimeouts(ctx, resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (m *databaseHostResourceModel) fromAPI(host apiDatabaseHost) {
+	m.ID = types.Int32Value(host.ID)
+	m.Name = types.StringValue(host.Name)
+	m.Host = types.StringValue(host.Host)
+	m.Port = types.Int32Value(host.Port)
+	m.Username = types.StringValue(host.Username)
+	m.CreatedAt = types.StringValue(host.CreatedAt.Format(time.RFC3339))
+	m.UpdatedAt = types.StringValue(host.UpdatedAt.Format(time.RFC3339))
+
+	// Pelican does not return the linked nodes, keep the configured one
+	if host.Node != nil {
+		m.NodeID = types.Int32Value(*host.Node)
+	}
+}
+
+func (m databaseHostResourceModel) toAPI() apiPartialDatabaseHost {
+	host := apiPartialDatabaseHost{
+		Name:     m.Name.ValueString(),
+		Host:     m.Host.ValueString(),
+		Port:     m.Port.ValueInt32(),
+		Username: m.Username.ValueString(),
+		Password: m.Password.ValueString(),
+		NodeID:   m.NodeID.ValueInt32Pointer(),
+		NodeIDs:  []int32{},
+	}
+	if !m.NodeID.IsNull() {
+		host.NodeIDs = append(host.NodeIDs, m.NodeID.ValueInt32())
+	}
+
+	return host
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDatabaseHostResourceRead(t *testing.T) {
+	state := replayResourceRead(t, NewDatabaseHostResource(), "database_host_resource_read", map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.Number, 1),
+		"name":     tftypes.NewValue(tftypes.String, "fra-mysql"),
+		"port":     tftypes.NewValue(tftypes.Number, 3306),
+		"password": tftypes.NewValue(tftypes.String, "hunter2"),
+		"node_id":  tftypes.NewValue(tftypes.Number, 2),
+	})
+
+	var host databaseHostResourceModel
+	replayStateGet(t, state, &host)
+
+	replayCheck(t, "id", host.ID, types.Int32Value(1))
+	replayCheck(t, "name", host.Name, types.StringValue("fra-mysql"))
+	replayCheck(t, "host", host.Host, types.StringValue("10.0.0.20"))
+	replayCheck(t, "port", host.Port, types.Int32Value(3306))
+	replayCheck(t, "username", host.Username, types.StringValue("pelican"))
+	// Neither the password nor the linked node are returned by Pelican.
+	replayCheck(t, "password", host.Password, types.StringValue("hunter2"))
+	replayCheck(t, "node_id", host.NodeID, types.Int32Value(2))
+	replayCheck(t, "created_at", host.CreatedAt, types.StringValue("2024-06-10T09:00:00Z"))
+	replayCheck(t, "updated_at", host.UpdatedAt, types.StringValue("2024-06-12T15:30:00Z"))
+}
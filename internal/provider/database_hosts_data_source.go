@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &databaseHostsDataSource{}
+	_ datasource.DataSourceWithConfigure = &databaseHostsDataSource{}
+)
+
+// NewDatabaseHostsDataSource is a helper function to simplify the provider implementation.
+func NewDatabaseHostsDataSource() datasource.DataSource {
+	return &databaseHostsDataSource{}
+}
+
+// databaseHostsDataSource is the data source implementation.
+type databaseHostsDataSource struct {
+	client *pterodactyl.Client
+}
+
+// databaseHostsDataSourceModel maps the data source schema data.
+type databaseHostsDataSourceModel struct {
+	DatabaseHosts []DatabaseHost `tfsdk:"database_hosts"`
+}
+
+// DatabaseHost schema data.
+type DatabaseHost struct {
+	ID        types.Int32  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Host      types.String `tfsdk:"host"`
+	Port      types.Int32  `tfsdk:"port"`
+	Username  types.String `tfsdk:"username"`
+	NodeID    types.Int32  `tfsdk:"node_id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *databaseHostsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_hosts"
+}
+
+// Schema defines the schema for the data source.
+func (d *databaseHostsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl database hosts data source allows Terraform to read database hosts from the Pterodactyl API. " +
+			"Database hosts are only exposed by the API of Pelican, the Pterodactyl Panel 1.x does not list them.",
+		Attributes: map[string]schema.Attribute{
+			"database_hosts": schema.ListNestedAttribute{
+				Description: "The list of database hosts.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Description: "The ID of the database host.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the database host.",
+							Computed:    true,
+						},
+						"host": schema.StringAttribute{
+							Description: "The IP address or domain name of the database host.",
+							Computed:    true,
+						},
+						"port": schema.Int32Attribute{
+							Description: "The port of the database host.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The username of the account the panel creates databases with.",
+							Computed:    true,
+						},
+						"node_id": schema.Int32Attribute{
+							Description: "The ID of the node the database host is linked to, if returned by the panel.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The creation date of the database host.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The last update date of the database host.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *databaseHostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state databaseHostsDataSourceModel
+
+	hosts, err := getDatabaseHosts(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Pterodactyl Database Hosts",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.DatabaseHosts = make([]DatabaseHost, 0, len(hosts))
+	for _, host := range hosts {
+		state.DatabaseHosts = append(state.DatabaseHosts, DatabaseHost{
+			ID:        types.Int32Value(host.ID),
+			Name:      types.StringValue(host.Name),
+			Host:      types.StringValue(host.Host),
+			Port:      types.Int32Value(host.Port),
+			Username:  types.StringValue(host.Username),
+			NodeID:    types.Int32PointerValue(host.Node),
+			CreatedAt: types.StringValue(host.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt: types.StringValue(host.UpdatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *databaseHostsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Application
+}
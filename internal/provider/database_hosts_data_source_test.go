@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDatabaseHostsDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewDatabaseHostsDataSource(), "database_hosts_data_source_read", nil)
+
+	var hosts databaseHostsDataSourceModel
+	replayStateGet(t, state, &hosts)
+
+	if len(hosts.DatabaseHosts) != 2 {
+		t.Fatalf("expected 2 database hosts, got %d", len(hosts.DatabaseHosts))
+	}
+
+	replayCheck(t, "database_hosts.0.name", hosts.DatabaseHosts[0].Name, types.StringValue("fra-mysql"))
+	replayCheck(t, "database_hosts.0.node_id", hosts.DatabaseHosts[0].NodeID, types.Int32Null())
+	replayCheck(t, "database_hosts.1.id", hosts.DatabaseHosts[1].ID, types.Int32Value(2))
+	replayCheck(t, "database_hosts.1.host", hosts.DatabaseHosts[1].Host, types.StringValue("db.nyc.example.com"))
+	replayCheck(t, "database_hosts.1.port", hosts.DatabaseHosts[1].Port, types.Int32Value(3307))
+	replayCheck(t, "database_hosts.1.username", hosts.DatabaseHosts[1].Username, types.StringValue("panel"))
+}
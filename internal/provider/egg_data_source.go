@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &eggDataSource{}
+	_ datasource.DataSourceWithConfigure = &eggDataSource{}
+)
+
+// eggDataSourceModel maps the data source schema data.
+type eggDataSourceModel struct {
+	ID           types.Int32             `tfsdk:"id"`
+	UUID         types.String            `tfsdk:"uuid"`
+	Name         types.String            `tfsdk:"name"`
+	NestID       types.Int32             `tfsdk:"nest_id"`
+	Author       types.String            `tfsdk:"author"`
+	Description  types.String            `tfsdk:"description"`
+	DockerImages map[string]types.String `tfsdk:"docker_images"`
+	Startup      types.String            `tfsdk:"startup"`
+	Variables    []EggVariable           `tfsdk:"variables"`
+	CreatedAt    types.String            `tfsdk:"created_at"`
+	UpdatedAt    types.String            `tfsdk:"updated_at"`
+}
+
+// NewEggDataSource is a helper function to simplify the provider implementation.
+func NewEggDataSource() datasource.DataSource {
+	return &eggDataSource{}
+}
+
+// eggDataSource is the data source implementation.
+type eggDataSource struct {
+	client *pterodactyl.Client
+}
+
+// Metadata returns the data source type name.
+func (d *eggDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_egg"
+}
+
+// Schema defines the schema for the data source.
+func (d *eggDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl egg data source allows Terraform to read an eggs data from the Pterodactyl Panel API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				Description: "The ID of the egg.",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("uuid"),
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "The UUID of the egg.",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("uuid"),
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the egg.",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("uuid"),
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"nest_id": schema.Int32Attribute{
+				Description: "The ID of the nest of the egg.",
+				Required:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"author": schema.StringAttribute{
+				Description: "The author of the egg.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the egg.",
+				Computed:    true,
+			},
+			"docker_images": schema.MapAttribute{
+				Description: "The docker images of the egg, keyed by their display name.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"startup": schema.StringAttribute{
+				Description: "The startup command of the egg.",
+				Computed:    true,
+			},
+			"variables": eggVariablesSchema(),
+			"created_at": schema.StringAttribute{
+				Description: "The creation date of the egg.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The last update date of the egg.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *eggDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state eggDataSourceModel
+
+	// Get the attributes from the request
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the egg from the API based on the provided attribute
+	var egg apiEgg
+	if !state.ID.IsNull() {
+		var err error
+		egg, err = getEgg(ctx, d.client, state.NestID.ValueInt32(), state.ID.ValueInt32())
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Pterodactyl Egg",
+				err.Error(),
+			)
+			return
+		}
+	} else if !state.UUID.IsNull() || !state.Name.IsNull() {
+		eggs, err := getNestEggs(ctx, d.client, state.NestID.ValueInt32())
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Pterodactyl Egg",
+				err.Error(),
+			)
+			return
+		}
+
+		found := false
+		for _, e := range eggs {
+			if (!state.UUID.IsNull() && e.UUID == state.UUID.ValueString()) ||
+				(!state.Name.IsNull() && e.Name == state.Name.ValueString()) {
+				egg = e
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			resp.Diagnostics.AddError(
+				"Unable to Read Pterodactyl Egg",
+				fmt.Sprintf("No egg with the given uuid or name exists in nest %d of the Pterodactyl Panel.", state.NestID.ValueInt32()),
+			)
+			return
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing Attribute",
+			"One of 'id', 'uuid' or 'name' must be specified.",
+		)
+		return
+	}
+
+	// Map response body to model
+	state = eggDataSourceModel{
+		ID:           types.Int32Value(egg.ID),
+		UUID:         types.StringValue(egg.UUID),
+		Name:         types.StringValue(egg.Name),
+		NestID:       types.Int32Value(egg.Nest),
+		Author:       types.StringValue(egg.Author),
+		Description:  types.StringValue(egg.Description),
+		DockerImages: eggDockerImages(egg),
+		Startup:      types.StringValue(egg.Startup),
+		Variables:    eggVariables(egg),
+		CreatedAt:    types.StringValue(egg.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:    types.StringValue(egg.UpdatedAt.Format(time.RFC3339)),
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *eggDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Application
+}
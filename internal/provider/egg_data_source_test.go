@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEggDataSourceRead(t *testing.T) {
+	testCases := map[string]struct {
+		fixture string
+		config  map[string]tftypes.Value
+	}{
+		"id": {
+			fixture: "egg_data_source_read_id",
+			config: map[string]tftypes.Value{
+				"nest_id": tftypes.NewValue(tftypes.Number, 1),
+				"id":      tftypes.NewValue(tftypes.Number, 3),
+			},
+		},
+		"name": {
+			fixture: "egg_data_source_read",
+			config: map[string]tftypes.Value{
+				"nest_id": tftypes.NewValue(tftypes.Number, 1),
+				"name":    tftypes.NewValue(tftypes.String, "Paper"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := replayDataSourceRead(t, NewEggDataSource(), testCase.fixture, testCase.config)
+
+			var egg eggDataSourceModel
+			replayStateGet(t, state, &egg)
+
+			replayCheck(t, "id", egg.ID, types.Int32Value(3))
+			replayCheck(t, "uuid", egg.UUID, types.StringValue("b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e"))
+			replayCheck(t, "name", egg.Name, types.StringValue("Paper"))
+			replayCheck(t, "author", egg.Author, types.StringValue("parker@pterodactyl.io"))
+			replayCheck(t, "docker_images.Java 21", egg.DockerImages["Java 21"], types.StringValue("ghcr.io/pterodactyl/yolks:java_21"))
+			replayCheck(t, "updated_at", egg.UpdatedAt, types.StringValue("2024-05-10T18:00:00Z"))
+
+			if len(egg.Variables) != 4 {
+				t.Fatalf("expected 4 variables, got %d", len(egg.Variables))
+			}
+			replayCheck(t, "variables.0.env_variable", egg.Variables[0].EnvVariable, types.StringValue("MINECRAFT_VERSION"))
+			replayCheck(t, "variables.0.default_value", egg.Variables[0].DefaultValue, types.StringValue("latest"))
+			replayCheck(t, "variables.1.rules", egg.Variables[1].Rules, types.StringValue(`required|regex:/^([\w\d._-]+)(\.jar)$/`))
+			// Variables without a default value are returned as null.
+			replayCheck(t, "variables.2.default_value", egg.Variables[2].DefaultValue, types.StringValue(""))
+			replayCheck(t, "variables.2.user_viewable", egg.Variables[2].UserViewable, types.BoolValue(false))
+			replayCheck(t, "variables.2.user_editable", egg.Variables[2].UserEditable, types.BoolValue(false))
+			replayCheck(t, "variables.3.name", egg.Variables[3].Name, types.StringValue("Build Number"))
+		})
+	}
+}
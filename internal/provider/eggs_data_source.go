@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &eggsDataSource{}
+	_ datasource.DataSourceWithConfigure = &eggsDataSource{}
+)
+
+// NewEggsDataSource is a helper function to simplify the provider implementation.
+func NewEggsDataSource() datasource.DataSource {
+	return &eggsDataSource{}
+}
+
+// eggsDataSource is the data source implementation.
+type eggsDataSource struct {
+	client *pterodactyl.Client
+}
+
+// eggsDataSourceModel maps the data source schema data.
+type eggsDataSourceModel struct {
+	NestID types.Int32 `tfsdk:"nest_id"`
+	Eggs   []Egg       `tfsdk:"eggs"`
+}
+
+// Egg schema data.
+type Egg struct {
+	ID           types.Int32             `tfsdk:"id"`
+	UUID         types.String            `tfsdk:"uuid"`
+	Name         types.String            `tfsdk:"name"`
+	NestID       types.Int32             `tfsdk:"nest_id"`
+	Author       types.String            `tfsdk:"author"`
+	Description  types.String            `tfsdk:"description"`
+	DockerImages map[string]types.String `tfsdk:"docker_images"`
+	Startup      types.String            `tfsdk:"startup"`
+	Variables    []EggVariable           `tfsdk:"variables"`
+	CreatedAt    types.String            `tfsdk:"created_at"`
+	UpdatedAt    types.String            `tfsdk:"updated_at"`
+}
+
+// EggVariable schema data.
+type EggVariable struct {
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	EnvVariable  types.String `tfsdk:"env_variable"`
+	DefaultValue types.String `tfsdk:"default_value"`
+	UserViewable types.Bool   `tfsdk:"user_viewable"`
+	UserEditable types.Bool   `tfsdk:"user_editable"`
+	Rules        types.String `tfsdk:"rules"`
+}
+
+// Metadata returns the data source type name.
+func (d *eggsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eggs"
+}
+
+// Schema defines the schema for the data source.
+func (d *eggsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl eggs data source allows Terraform to read the eggs of a nest from the Pterodactyl API.",
+		Attributes: map[string]schema.Attribute{
+			"nest_id": schema.Int32Attribute{
+				Description: "The ID of the nest.",
+				Required:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"eggs": schema.ListNestedAttribute{
+				Description: "The list of eggs.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Description: "The ID of the egg.",
+							Computed:    true,
+						},
+						"uuid": schema.StringAttribute{
+							Description: "The UUID of the egg.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the egg.",
+							Computed:    true,
+						},
+						"nest_id": schema.Int32Attribute{
+							Description: "The ID of the nest of the egg.",
+							Computed:    true,
+						},
+						"author": schema.StringAttribute{
+							Description: "The author of the egg.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the egg.",
+							Computed:    true,
+						},
+						"docker_images": schema.MapAttribute{
+							Description: "The docker images of the egg, keyed by their display name.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"startup": schema.StringAttribute{
+							Description: "The startup command of the egg.",
+							Computed:    true,
+						},
+						"variables": eggVariablesSchema(),
+						"created_at": schema.StringAttribute{
+							Description: "The creation date of the egg.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The last update date of the egg.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// eggVariablesSchema returns the schema of the variables of an egg, shared by
+// the egg and eggs data sources.
+func eggVariablesSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "The variables of the egg.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "The name of the variable.",
+					Computed:    true,
+				},
+				"description": schema.StringAttribute{
+					Description: "The description of the variable.",
+					Computed:    true,
+				},
+				"env_variable": schema.StringAttribute{
+					Description: "The name of the environment variable set on servers.",
+					Computed:    true,
+				},
+				"default_value": schema.StringAttribute{
+					Description: "The default value of the variable.",
+					Computed:    true,
+				},
+				"user_viewable": schema.BoolAttribute{
+					Description: "Whether users can see the variable.",
+					Computed:    true,
+				},
+				"user_editable": schema.BoolAttribute{
+					Description: "Whether users can edit the variable.",
+					Computed:    true,
+				},
+				"rules": schema.StringAttribute{
+					Description: "The Laravel validation rules of the variable.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *eggsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state eggsDataSourceModel
+
+	// Get the attributes from the request
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eggs, err := getNestEggs(ctx, d.client, state.NestID.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Pterodactyl Eggs",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Eggs = make([]Egg, 0, len(eggs))
+	for _, egg := range eggs {
+		state.Eggs = append(state.Eggs, Egg{
+			ID:           types.Int32Value(egg.ID),
+			UUID:         types.StringValue(egg.UUID),
+			Name:         types.StringValue(egg.Name),
+			NestID:       types.Int32Value(egg.Nest),
+			Author:       types.StringValue(egg.Author),
+			Description:  types.StringValue(egg.Description),
+			DockerImages: eggDockerImages(egg),
+			Startup:      types.StringValue(egg.Startup),
+			Variables:    eggVariables(egg),
+			CreatedAt:    types.StringValue(egg.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:    types.StringValue(egg.UpdatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// eggDockerImages maps the docker images of egg to the schema.
+func eggDockerImages(egg apiEgg) map[string]types.String {
+	images := make(map[string]types.String, len(egg.DockerImages))
+	for name, image := range egg.DockerImages {
+		images[name] = types.StringValue(image)
+	}
+	return images
+}
+
+// eggVariables maps the variables of egg to the schema.
+func eggVariables(egg apiEgg) []EggVariable {
+	apiVariables := egg.variables()
+
+	variables := make([]EggVariable, 0, len(apiVariables))
+	for _, variable := range apiVariables {
+		variables = append(variables, EggVariable{
+			Name:         types.StringValue(variable.Name),
+			Description:  types.StringValue(variable.Description),
+			EnvVariable:  types.StringValue(variable.EnvVariable),
+			DefaultValue: types.StringValue(variable.DefaultValue),
+			UserViewable: types.BoolValue(variable.UserViewable),
+			UserEditable: types.BoolValue(variable.UserEditable),
+			Rules:        types.StringValue(variable.Rules),
+		})
+	}
+	return variables
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *eggsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Application
+}
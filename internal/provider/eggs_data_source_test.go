@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEggsDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewEggsDataSource(), "eggs_data_source_read", map[string]tftypes.Value{
+		"nest_id": tftypes.NewValue(tftypes.Number, 1),
+	})
+
+	var eggs eggsDataSourceModel
+	replayStateGet(t, state, &eggs)
+
+	if len(eggs.Eggs) != 2 {
+		t.Fatalf("expected 2 eggs, got %d", len(eggs.Eggs))
+	}
+
+	replayCheck(t, "eggs.0.name", eggs.Eggs[0].Name, types.StringValue("Paper"))
+	replayCheck(t, "eggs.0.nest_id", eggs.Eggs[0].NestID, types.Int32Value(1))
+	replayCheck(t, "eggs.0.docker_images.Java 17", eggs.Eggs[0].DockerImages["Java 17"], types.StringValue("ghcr.io/pterodactyl/yolks:java_17"))
+	replayCheck(t, "eggs.1.id", eggs.Eggs[1].ID, types.Int32Value(5))
+	replayCheck(t, "eggs.1.startup", eggs.Eggs[1].Startup, types.StringValue("java -Xms128M -Xmx{{SERVER_MEMORY}}M -jar {{SERVER_JARFILE}}"))
+
+	if len(eggs.Eggs[1].Variables) != 2 {
+		t.Fatalf("expected 2 variables, got %d", len(eggs.Eggs[1].Variables))
+	}
+	replayCheck(t, "eggs.1.variables.1.env_variable", eggs.Eggs[1].Variables[1].EnvVariable, types.StringValue("VANILLA_VERSION"))
+	replayCheck(t, "eggs.1.variables.1.rules", eggs.Eggs[1].Variables[1].Rules, types.StringValue("required|string|between:3,15"))
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// apiError - Error response of the Pterodactyl Panel API
+type apiError struct {
+	StatusCode int
+	Body       string
+	// Errors are the errors listed in the body, if it could be parsed.
+	Errors []apiErrorObject
+}
+
+// apiErrorObject - Single error of an error response
+type apiErrorObject struct {
+	Code   string             `json:"code"`
+	Status string             `json:"status"`
+	Detail string             `json:"detail"`
+	Meta   apiErrorObjectMeta `json:"meta"`
+}
+
+// apiErrorObjectMeta - Meta information of an error, only set on validation errors
+type apiErrorObjectMeta struct {
+	SourceField string `json:"source_field"`
+	Rule        string `json:"rule"`
+}
+
+type apiErrorResponse struct {
+	Errors []apiErrorObject `json:"errors"`
+}
+
+// newAPIError returns the error for a response of the panel with a status
+// code outside of the 2xx range.
+func newAPIError(statusCode int, body string) *apiError {
+	var response apiErrorResponse
+	// Not every error has a JSON body, e.g. those of a reverse proxy.
+	_ = json.Unmarshal([]byte(body), &response)
+
+	return &apiError{
+		StatusCode: statusCode,
+		Body:       body,
+		Errors:     response.Errors,
+	}
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// asAPIError returns the API error wrapped by err. Errors of
+// pterodactyl-client-go are plain strings, so they are parsed back from the
+// "status: <code>, body: <body>" format both clients use.
+func asAPIError(err error) (*apiError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+
+	rest, ok := strings.CutPrefix(err.Error(), "status: ")
+	if !ok {
+		return nil, false
+	}
+
+	code, body, ok := strings.Cut(rest, ", body: ")
+	if !ok {
+		return nil, false
+	}
+
+	statusCode, convErr := strconv.Atoi(code)
+	if convErr != nil {
+		return nil, false
+	}
+
+	return newAPIError(statusCode, body), true
+}
+
+// isNotFound reports whether err is the panel telling the requested object
+// does not exist (anymore).
+func isNotFound(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// apiFieldPaths maps the source fields of validation errors returned by the
+// panel onto the attribute paths of a schema.
+type apiFieldPaths map[string]path.Path
+
+// rootAPIFields returns the apiFieldPaths of fields named like the root
+// attributes they set.
+func rootAPIFields(names ...string) apiFieldPaths {
+	fields := make(apiFieldPaths, len(names))
+	for _, name := range names {
+		fields[name] = path.Root(name)
+	}
+
+	return fields
+}
+
+// lookup returns the attribute path of field. Fields of nested values, such
+// as "environment.SERVER_JARFILE" or "ports.0", fall back to the
+// path of their parent.
+func (f apiFieldPaths) lookup(field string) (path.Path, bool) {
+	for {
+		if p, ok := f[field]; ok {
+			return p, true
+		}
+
+		i := strings.LastIndex(field, ".")
+		if i < 0 {
+			return path.Empty(), false
+		}
+		field = field[:i]
+	}
+}
+
+// addAPIError adds err to diags. Errors of the panel pointing at a field of
+// fields are added as attribute errors with the message of the panel, any
+// other error is added as a single error with summary and detail.
+func addAPIError(diags *diag.Diagnostics, fields apiFieldPaths, err error, summary, detail string) {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		diags.AddError(summary, detail)
+		return
+	}
+
+	unmapped := false
+	for _, e := range apiErr.Errors {
+		p, ok := fields.lookup(e.Meta.SourceField)
+		if !ok {
+			unmapped = true
+			continue
+		}
+
+		diags.AddAttributeError(p, summary, "The Pterodactyl Panel rejected the value: "+e.Detail)
+	}
+
+	if unmapped || len(apiErr.Errors) == 0 {
+		diags.AddError(summary, detail)
+	}
+}
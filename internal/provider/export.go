@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Export writes the locations, nodes and users of the panel to dir as
+// Terraform configuration, with import blocks adopting them into the state.
+// The provider is configured like Terraform does without a provider block,
+// from the PTERODACTYL_* environment variables. Existing files are never
+// overwritten, nothing is written when one of the files exists.
+func Export(ctx context.Context, version string, dir string) ([]string, diag.Diagnostics) {
+	clients, diags := configureFromEnvironment(ctx, version)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	files, err := exportPanel(ctx, clients.Application)
+	if err != nil {
+		diags.AddError(
+			"Unable to Export Pterodactyl Panel",
+			"Could not read the panel: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	written, err := writeExportFiles(dir, files)
+	if err != nil {
+		diags.AddError(
+			"Unable to Export Pterodactyl Panel",
+			"Could not write "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	return written, diags
+}
+
+// configureFromEnvironment configures the provider with an empty provider
+// block and returns the clients it passes to resources.
+func configureFromEnvironment(ctx context.Context, version string) (*pterodactylClients, diag.Diagnostics) {
+	p := &pterodactylProvider{version: version}
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	return resp.ResourceData.(*pterodactylClients), resp.Diagnostics
+}
+
+// exportPanel returns the configuration of the locations, nodes and users of
+// the panel, keyed by the name of their file.
+func exportPanel(ctx context.Context, c *pterodactyl.Client) (map[string][]byte, error) {
+	locations, err := getLocations(ctx, c, nil)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(locations, func(a, b apiLocation) int { return cmp.Compare(a.ID, b.ID) })
+
+	nodes, err := getNodes(ctx, c, nil)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(nodes, func(a, b pterodactyl.Node) int { return cmp.Compare(a.ID, b.ID) })
+
+	users, err := getUsers(ctx, c, nil)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(users, func(a, b pterodactyl.User) int { return cmp.Compare(a.ID, b.ID) })
+
+	names := exportNames{}
+
+	// Nodes refer to the resources of their locations.
+	locationNames := make(map[int32]string, len(locations))
+	locationsFile := hclwrite.NewEmptyFile()
+	for _, location := range locations {
+		name := names.unique("pterodactyl_location", location.Short)
+		locationNames[location.ID] = name
+
+		body := appendExportResource(locationsFile, "pterodactyl_location", name, strconv.Itoa(int(location.ID)))
+		body.SetAttributeValue("short", cty.StringVal(location.Short))
+		body.SetAttributeValue("long", cty.StringVal(location.Long))
+	}
+
+	nodesFile := hclwrite.NewEmptyFile()
+	for _, node := range nodes {
+		allocations, err := getNodeAllocations(ctx, c, node.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		name := names.unique("pterodactyl_node", node.Name)
+		body := appendExportResource(nodesFile, "pterodactyl_node", name, strconv.Itoa(int(node.ID)))
+		body.SetAttributeValue("name", cty.StringVal(node.Name))
+		body.SetAttributeValue("description", cty.StringVal(node.Description))
+		if locationName, ok := locationNames[node.LocationID]; ok {
+			body.SetAttributeTraversal("location_id", hcl.Traversal{
+				hcl.TraverseRoot{Name: "pterodactyl_location"},
+				hcl.TraverseAttr{Name: locationName},
+				hcl.TraverseAttr{Name: "id"},
+			})
+		} else {
+			body.SetAttributeValue("location_id", cty.NumberIntVal(int64(node.LocationID)))
+		}
+		body.SetAttributeValue("public", cty.BoolVal(node.Public))
+		body.SetAttributeValue("behind_proxy", cty.BoolVal(node.BehindProxy))
+		body.SetAttributeValue("maintenance_mode", cty.BoolVal(node.MaintenanceMode))
+		body.SetAttributeValue("fqdn", cty.StringVal(node.FQDN))
+		body.SetAttributeValue("scheme", cty.StringVal(node.Scheme))
+		body.SetAttributeValue("memory", cty.NumberIntVal(int64(node.Memory)))
+		body.SetAttributeValue("memory_overallocate", cty.NumberIntVal(int64(node.MemoryOverallocate)))
+		body.SetAttributeValue("disk", cty.NumberIntVal(int64(node.Disk)))
+		body.SetAttributeValue("disk_overallocate", cty.NumberIntVal(int64(node.DiskOverallocate)))
+		body.SetAttributeValue("upload_size", cty.NumberIntVal(int64(node.UploadSize)))
+		body.SetAttributeValue("daemon_sftp", cty.NumberIntVal(int64(node.DaemonSFTP)))
+		body.SetAttributeValue("daemon_listen", cty.NumberIntVal(int64(node.DaemonListen)))
+		body.SetAttributeRaw("allocations", exportAllocations(allocations))
+	}
+
+	usersFile := hclwrite.NewEmptyFile()
+	for _, user := range users {
+		// Users are imported by their ID, unlike their username it never
+		// changes.
+		name := names.unique("pterodactyl_user", user.Username)
+		body := appendExportResource(usersFile, "pterodactyl_user", name, "id:"+strconv.Itoa(int(user.ID)))
+		body.SetAttributeValue("username", cty.StringVal(user.Username))
+		body.SetAttributeValue("email", cty.StringVal(user.Email))
+		body.SetAttributeValue("first_name", cty.StringVal(user.FirstName))
+		body.SetAttributeValue("last_name", cty.StringVal(user.LastName))
+		body.SetAttributeValue("root_admin", cty.BoolVal(user.RootAdmin))
+		body.SetAttributeValue("language", cty.StringVal(user.Language))
+		if user.ExternalID != "" {
+			body.SetAttributeValue("external_id", cty.StringVal(user.ExternalID))
+		}
+	}
+
+	return map[string][]byte{
+		"locations.tf": hclwrite.Format(locationsFile.Bytes()),
+		"nodes.tf":     hclwrite.Format(nodesFile.Bytes()),
+		"users.tf":     hclwrite.Format(usersFile.Bytes()),
+	}, nil
+}
+
+// appendExportResource appends a resource block and the import block
+// adopting the object with the import ID id to file, and returns the body of
+// the resource block.
+func appendExportResource(file *hclwrite.File, resourceType string, name string, id string) *hclwrite.Body {
+	if len(file.Body().Blocks()) > 0 {
+		file.Body().AppendNewline()
+	}
+
+	importBody := file.Body().AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBody.SetAttributeValue("id", cty.StringVal(id))
+	file.Body().AppendNewline()
+
+	return file.Body().AppendNewBlock("resource", []string{resourceType, name}).Body()
+}
+
+// exportAllocations returns the allocations of a node as a list with one
+// object per line, sorted by IP and port.
+func exportAllocations(allocations []apiAllocation) hclwrite.Tokens {
+	slices.SortFunc(allocations, func(a, b apiAllocation) int {
+		return cmp.Or(cmp.Compare(a.IP, b.IP), cmp.Compare(a.Port, b.Port))
+	})
+	if len(allocations) == 0 {
+		return hclwrite.TokensForTuple(nil)
+	}
+
+	tokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")},
+		{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+	}
+	for _, allocation := range allocations {
+		attributes := []hclwrite.ObjectAttrTokens{
+			{Name: hclwrite.TokensForIdentifier("ip"), Value: hclwrite.TokensForValue(cty.StringVal(allocation.IP))},
+			{Name: hclwrite.TokensForIdentifier("port"), Value: hclwrite.TokensForValue(cty.NumberIntVal(int64(allocation.Port)))},
+		}
+		if allocation.Alias != nil {
+			attributes = append(attributes, hclwrite.ObjectAttrTokens{
+				Name: hclwrite.TokensForIdentifier("alias"), Value: hclwrite.TokensForValue(cty.StringVal(*allocation.Alias)),
+			})
+		}
+		if allocation.Notes != nil {
+			attributes = append(attributes, hclwrite.ObjectAttrTokens{
+				Name: hclwrite.TokensForIdentifier("notes"), Value: hclwrite.TokensForValue(cty.StringVal(*allocation.Notes)),
+			})
+		}
+
+		tokens = append(tokens, hclwrite.TokensForObject(attributes)...)
+		tokens = append(tokens,
+			&hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")},
+			&hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+		)
+	}
+
+	return append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+}
+
+// exportNameInvalid matches the characters not allowed in resource names.
+var exportNameInvalid = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// exportNames hands out unique resource names per resource type.
+type exportNames map[string]bool
+
+// unique returns a valid resource name derived from value, e.g. fra_1 for
+// fra-1, numbered when the name is already taken.
+func (n exportNames) unique(resourceType string, value string) string {
+	name := strings.Trim(exportNameInvalid.ReplaceAllString(strings.ToLower(value), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = strings.TrimPrefix(resourceType, "pterodactyl_") + "_" + name
+		name = strings.TrimSuffix(name, "_")
+	}
+
+	unique := name
+	for i := 2; n[resourceType+"."+unique]; i++ {
+		unique = name + "_" + strconv.Itoa(i)
+	}
+	n[resourceType+"."+unique] = true
+
+	return unique
+}
+
+// writeExportFiles writes the files to dir and returns their paths. Either all
+// files are written or none, files written before a failure are removed.
+func writeExportFiles(dir string, files map[string][]byte) ([]string, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	// Check all files first, so an existing one does not leave the others
+	// behind
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+
+		_, err := os.Lstat(path)
+		if err == nil {
+			return nil, fmt.Errorf("%s: the file already exists, remove it or export to another directory", path)
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	var written []string
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+
+		err := writeNewFile(path, files[name])
+		if err != nil {
+			for _, path := range written {
+				os.Remove(path)
+			}
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		written = append(written, path)
+	}
+
+	return written, nil
+}
+
+// writeNewFile writes data to the file at path, failing if it exists. The file
+// is removed again when writing fails.
+func writeNewFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if errors.Is(err, os.ErrExist) {
+		return fmt.Errorf("the file already exists, remove it or export to another directory")
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+	}
+
+	return err
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExportPanel(t *testing.T) {
+	files, err := exportPanel(context.Background(), newReplayClients(t, "export_panel").Application)
+	if err != nil {
+		t.Fatalf("exporting the panel: %s", err)
+	}
+
+	for _, name := range []string{"locations.tf", "nodes.tf", "users.tf"} {
+		expected, err := os.ReadFile(filepath.Join("testdata", "export", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(files[name]) != string(expected) {
+			t.Errorf("%s is\n%s\nexpected\n%s", name, files[name], expected)
+		}
+	}
+	if len(files) != 3 {
+		t.Errorf("exported %d files, expected 3", len(files))
+	}
+}
+
+func TestWriteExportFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"locations.tf": []byte("# locations\n"),
+		"nodes.tf":     []byte("# nodes\n"),
+		"users.tf":     []byte("# users\n"),
+	}
+
+	// An existing file fails the export before any file is written.
+	existing := filepath.Join(dir, "users.tf")
+	if err := os.WriteFile(existing, []byte("# mine\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := writeExportFiles(dir, files); err == nil {
+		t.Fatal("expected an error for the existing users.tf")
+	}
+	for _, name := range []string{"locations.tf", "nodes.tf"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Errorf("%s was written, expected no files", name)
+		}
+	}
+	if data, _ := os.ReadFile(existing); string(data) != "# mine\n" {
+		t.Errorf("users.tf was overwritten with %q", data)
+	}
+
+	if err := os.Remove(existing); err != nil {
+		t.Fatal(err)
+	}
+	written, err := writeExportFiles(dir, files)
+	if err != nil {
+		t.Fatalf("writing the files: %s", err)
+	}
+	if len(written) != 3 {
+		t.Errorf("wrote %v, expected 3 files", written)
+	}
+}
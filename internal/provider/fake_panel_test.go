@@ -0,0 +1,1020 @@
+package provider
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+)
+
+// fakePanelAPIKey is the application API key accepted by the fake panel.
+const fakePanelAPIKey = "ptla_fakepanel"
+
+// fakePanelPerPage is the default page size of list endpoints, matching the panel.
+const fakePanelPerPage = 50
+
+// fakePanel is an in-memory implementation of the parts of the Pterodactyl
+// application API used by the provider, so resources can be tested without
+// a real panel.
+type fakePanel struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	lastID      int32
+	users       map[int32]*pterodactyl.User
+	passwords   map[int32]string
+	locations   map[int32]*pterodactyl.Location
+	nodes       map[int32]*pterodactyl.Node
+	allocations map[int32]*fakeAllocation
+	servers     map[int32]*apiServer
+}
+
+// fakeAllocation is an allocation together with the node it belongs to.
+type fakeAllocation struct {
+	apiAllocation
+	NodeID int32
+}
+
+// newFakePanel starts a fake panel, which is shut down at the end of the test.
+func newFakePanel(t testing.TB) *fakePanel {
+	t.Helper()
+
+	p := &fakePanel{
+		users:       map[int32]*pterodactyl.User{},
+		passwords:   map[int32]string{},
+		locations:   map[int32]*pterodactyl.Location{},
+		nodes:       map[int32]*pterodactyl.Node{},
+		allocations: map[int32]*fakeAllocation{},
+		servers:     map[int32]*apiServer{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/application/users", p.listUsers)
+	mux.HandleFunc("GET /api/application/users/{$}", p.listUsers)
+	mux.HandleFunc("POST /api/application/users", p.createUser)
+	mux.HandleFunc("GET /api/application/users/{id}", p.getUser)
+	mux.HandleFunc("GET /api/application/users/external/{external_id}", p.getUserExternal)
+	mux.HandleFunc("PATCH /api/application/users/{id}", p.updateUser)
+	mux.HandleFunc("DELETE /api/application/users/{id}", p.deleteUser)
+
+	mux.HandleFunc("GET /api/application/locations", p.listLocations)
+	mux.HandleFunc("POST /api/application/locations", p.createLocation)
+	mux.HandleFunc("GET /api/application/locations/{id}", p.getLocation)
+	mux.HandleFunc("PATCH /api/application/locations/{id}", p.updateLocation)
+	mux.HandleFunc("DELETE /api/application/locations/{id}", p.deleteLocation)
+
+	mux.HandleFunc("GET /api/application/nodes", p.listNodes)
+	mux.HandleFunc("POST /api/application/nodes", p.createNode)
+	mux.HandleFunc("GET /api/application/nodes/{id}", p.getNode)
+	mux.HandleFunc("PATCH /api/application/nodes/{id}", p.updateNode)
+	mux.HandleFunc("DELETE /api/application/nodes/{id}", p.deleteNode)
+	mux.HandleFunc("GET /api/application/nodes/{id}/configuration", p.getNodeConfiguration)
+	mux.HandleFunc("GET /api/application/nodes/{id}/allocations", p.listAllocations)
+	mux.HandleFunc("POST /api/application/nodes/{id}/allocations", p.createAllocations)
+	mux.HandleFunc("DELETE /api/application/nodes/{id}/allocations/{allocation}", p.deleteAllocation)
+
+	mux.HandleFunc("POST /api/application/servers", p.createServer)
+	mux.HandleFunc("GET /api/application/servers/{id}", p.getServer)
+	mux.HandleFunc("PATCH /api/application/servers/{id}/details", p.updateServerDetails)
+	mux.HandleFunc("PATCH /api/application/servers/{id}/build", p.updateServerBuild)
+	mux.HandleFunc("PATCH /api/application/servers/{id}/startup", p.updateServerStartup)
+	mux.HandleFunc("DELETE /api/application/servers/{id}", p.deleteServer)
+
+	p.Server = httptest.NewServer(p.authenticate(mux))
+	t.Cleanup(p.Close)
+
+	return p
+}
+
+// authenticate rejects requests without the application API key, like the
+// panel does.
+func (p *fakePanel) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+fakePanelAPIKey {
+			writeFakeError(w, http.StatusUnauthorized, "AuthenticationException", "Unauthenticated.")
+			return
+		}
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (p *fakePanel) nextID() int32 {
+	p.lastID++
+	return p.lastID
+}
+
+// fakeNow returns the current time with the precision of the panel.
+func fakeNow() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+func fakeUUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// Users
+
+type fakeUserRequest struct {
+	ExternalID *string `json:"external_id"`
+	Username   string  `json:"username"`
+	Email      string  `json:"email"`
+	FirstName  string  `json:"first_name"`
+	LastName   string  `json:"last_name"`
+	Language   string  `json:"language"`
+	RootAdmin  *bool   `json:"root_admin"`
+	Password   string  `json:"password"`
+}
+
+func (p *fakePanel) listUsers(w http.ResponseWriter, r *http.Request) {
+	users := make([]interface{}, 0, len(p.users))
+	for _, id := range sortedKeys(p.users) {
+		users = append(users, p.users[id])
+	}
+
+	writeFakeList(w, r, "user", users, "email", "uuid", "username", "external_id")
+}
+
+func (p *fakePanel) getUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := fakeLookup(w, r, "id", p.users)
+	if !ok {
+		return
+	}
+
+	writeFakeObject(w, http.StatusOK, "user", user)
+}
+
+func (p *fakePanel) getUserExternal(w http.ResponseWriter, r *http.Request) {
+	for _, id := range sortedKeys(p.users) {
+		if p.users[id].ExternalID != "" && p.users[id].ExternalID == r.PathValue("external_id") {
+			writeFakeObject(w, http.StatusOK, "user", p.users[id])
+			return
+		}
+	}
+
+	writeFakeNotFound(w)
+}
+
+func (p *fakePanel) createUser(w http.ResponseWriter, r *http.Request) {
+	var body fakeUserRequest
+	if !decodeFakeBody(w, r, &body) {
+		return
+	}
+
+	if !p.validateUser(w, 0, body) {
+		return
+	}
+
+	now := fakeNow()
+	user := &pterodactyl.User{
+		ID:        p.nextID(),
+		UUID:      fakeUUID(),
+		Username:  body.Username,
+		Email:     body.Email,
+		FirstName: body.FirstName,
+		LastName:  body.LastName,
+		Language:  "en",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	applyFakeUser(user, body)
+	p.users[user.ID] = user
+	p.passwords[user.ID] = body.Password
+
+	writeFakeObject(w, http.StatusCreated, "user", user)
+}
+
+func (p *fakePanel) updateUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := fakeLookup(w, r, "id", p.users)
+	if !ok {
+		return
+	}
+
+	var body fakeUserRequest
+	if !decodeFakeBody(w, r, &body) {
+		return
+	}
+
+	if !p.validateUser(w, user.ID, body) {
+		return
+	}
+
+	user.Username = body.Username
+	user.Email = body.Email
+	user.FirstName = body.FirstName
+	user.LastName = body.LastName
+	applyFakeUser(user, body)
+	user.UpdatedAt = fakeNow()
+
+	// The current password is kept when none is sent
+	if body.Password != "" {
+		p.passwords[user.ID] = body.Password
+	}
+
+	writeFakeObject(w, http.StatusOK, "user", user)
+}
+
+func applyFakeUser(user *pterodactyl.User, body fakeUserRequest) {
+	if body.ExternalID != nil {
+		user.ExternalID = *body.ExternalID
+	}
+	if body.Language != "" {
+		user.Language = body.Language
+	}
+	if body.RootAdmin != nil {
+		user.RootAdmin = *body.RootAdmin
+	}
+}
+
+func (p *fakePanel) validateUser(w http.ResponseWriter, id int32, body fakeUserRequest) bool {
+	var errs fakeValidationErrors
+	errs.required("email", body.Email)
+	if _, err := mail.ParseAddress(body.Email); body.Email != "" && err != nil {
+		errs.add("email", "email", "The email must be a valid email address.")
+	}
+	errs.required("username", body.Username)
+	errs.required("first_name", body.FirstName)
+	errs.required("last_name", body.LastName)
+
+	for _, user := range p.users {
+		if user.ID == id {
+			continue
+		}
+		if strings.EqualFold(user.Email, body.Email) {
+			errs.add("email", "unique", "The email has already been taken.")
+		}
+		if strings.EqualFold(user.Username, body.Username) {
+			errs.add("username", "unique", "The username has already been taken.")
+		}
+	}
+
+	return errs.write(w)
+}
+
+func (p *fakePanel) deleteUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := fakeLookup(w, r, "id", p.users)
+	if !ok {
+		return
+	}
+
+	delete(p.users, user.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Locations
+
+func (p *fakePanel) listLocations(w http.ResponseWriter, r *http.Request) {
+	locations := make([]interface{}, 0, len(p.locations))
+	for _, id := range sortedKeys(p.locations) {
+		locations = append(locations, p.locations[id])
+	}
+
+	writeFakeList(w, r, "location", locations, "short", "long")
+}
+
+func (p *fakePanel) getLocation(w http.ResponseWriter, r *http.Request) {
+	location, ok := fakeLookup(w, r, "id", p.locations)
+	if !ok {
+		return
+	}
+
+	writeFakeObject(w, http.StatusOK, "location", location)
+}
+
+func (p *fakePanel) createLocation(w http.ResponseWriter, r *http.Request) {
+	var body pterodactyl.PartialLocation
+	if !decodeFakeBody(w, r, &body) {
+		return
+	}
+
+	if !p.validateLocation(w, 0, body) {
+		return
+	}
+
+	now := fakeNow()
+	location := &pterodactyl.Location{
+		ID:        p.nextID(),
+		Short:     body.Short,
+		Long:      body.Long,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	p.locations[location.ID] = location
+
+	writeFakeObject(w, http.StatusCreated, "location", location)
+}
+
+func (p *fakePanel) updateLocation(w http.ResponseWriter, r *http.Request) {
+	location, ok := fakeLookup(w, r, "id", p.locations)
+	if !ok {
+		return
+	}
+
+	var body pterodactyl.PartialLocation
+	if !decodeFakeBody(w, r, &body) {
+		return
+	}
+
+	if !p.validateLocation(w, location.ID, body) {
+		return
+	}
+
+	location.Short = body.Short
+	location.Long = body.Long
+	location.UpdatedAt = fakeNow()
+
+	writeFakeObject(w, http.StatusOK, "location", location)
+}
+
+func (p *fakePanel) validateLocation(w http.ResponseWriter, id int32, body pterodactyl.PartialLocation) bool {
+	var errs fakeValidationErrors
+	errs.required("short", body.Short)
+	if len(body.Short) > 60 {
+		errs.add("short", "between", "The short must be between 1 and 60 characters.")
+	}
+	if len(body.Long) > 191 {
+		errs.add("long", "between", "The long must be between 1 and 191 characters.")
+	}
+
+	for _, location := range p.locations {
+		if location.ID != id && location.Short == body.Short {
+			errs.add("short", "unique", "The short has already been taken.")
+		}
+	}
+
+	return errs.write(w)
+}
+
+func (p *fakePanel) deleteLocation(w http.ResponseWriter, r *http.Request) {
+	location, ok := fakeLookup(w, r, "id", p.locations)
+	if !ok {
+		return
+	}
+
+	for _, node := range p.nodes {
+		if node.LocationID == location.ID {
+			writeFakeError(w, http.StatusBadRequest, "HasActiveNodesException", "Cannot delete a location that has active nodes attached to it.")
+			return
+		}
+	}
+
+	delete(p.locations, location.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Nodes
+
+func (p *fakePanel) listNodes(w http.ResponseWriter, r *http.Request) {
+	nodes := make([]interface{}, 0, len(p.nodes))
+	for _, id := range sortedKeys(p.nodes) {
+		nodes = append(nodes, p.nodes[id])
+	}
+
+	writeFakeList(w, r, "node", nodes, "uuid", "name", "fqdn")
+}
+
+func (p *fakePanel) getNode(w http.ResponseWriter, r *http.Request) {
+	node, ok := fakeLookup(w, r, "id", p.nodes)
+	if !ok {
+		return
+	}
+
+	writeFakeObject(w, http.StatusOK, "node", node)
+}
+
+func (p *fakePanel) createNode(w http.ResponseWriter, r *http.Request) {
+	var body pterodactyl.PartialNode
+	if !decodeFakeBody(w, r, &body) {
+		return
+	}
+
+	if !p.validateNode(w, body) {
+		return
+	}
+
+	now := fakeNow()
+	node := &pterodactyl.Node{
+		ID:         p.nextID(),
+		UUID:       fakeUUID(),
+		DaemonBase: "/var/lib/pterodactyl/volumes",
+		CreatedAt:  now,
+	}
+	applyFakeNode(node, body)
+	p.nodes[node.ID] = node
+
+	writeFakeObject(w, http.StatusCreated, "node", node)
+}
+
+func (p *fakePanel) updateNode(w http.ResponseWriter, r *http.Request) {
+	node, ok := fakeLookup(w, r, "id", p.nodes)
+	if !ok {
+		return
+	}
+
+	var body pterodactyl.PartialNode
+	if !decodeFakeBody(w, r, &body) {
+		return
+	}
+
+	if !p.validateNode(w, body) {
+		return
+	}
+
+	applyFakeNode(node, body)
+
+	writeFakeObject(w, http.StatusOK, "node", node)
+}
+
+func applyFakeNode(node *pterodactyl.Node, body pterodactyl.PartialNode) {
+	node.Name = body.Name
+	node.Description = body.Description
+	node.Public = body.Public
+	node.BehindProxy = body.BehindProxy
+	node.MaintenanceMode = body.MaintenanceMode
+	node.LocationID = body.LocationID
+	node.FQDN = body.FQDN
+	node.Scheme = body.Scheme
+	node.Memory = body.Memory
+	node.MemoryOverallocate = body.MemoryOverallocate
+	node.Disk = body.Disk
+	node.DiskOverallocate = body.DiskOverallocate
+	node.UploadSize = body.UploadSize
+	node.DaemonSFTP = body.DaemonSFTP
+	node.DaemonListen = body.DaemonListen
+	node.UpdatedAt = fakeNow()
+}
+
+func (p *fakePanel) validateNode(w http.ResponseWriter, body pterodactyl.PartialNode) bool {
+	var errs fakeValidationErrors
+	errs.required("name", body.Name)
+	errs.required("fqdn", body.FQDN)
+	if _, ok := p.locations[body.LocationID]; !ok {
+		errs.add("location_id", "exists", "The selected location id is invalid.")
+	}
+	if body.Scheme != "http" && body.Scheme != "https" {
+		errs.add("scheme", "in", "The selected scheme is invalid.")
+	}
+	if body.Memory < 0 {
+		errs.add("memory", "min", "The memory must be at least 0.")
+	}
+	if body.Disk < 0 {
+		errs.add("disk", "min", "The disk must be at least 0.")
+	}
+	if body.UploadSize < 1 || body.UploadSize > 1024 {
+		errs.add("upload_size", "between", "The upload size must be between 1 and 1024.")
+	}
+
+	return errs.write(w)
+}
+
+func (p *fakePanel) deleteNode(w http.ResponseWriter, r *http.Request) {
+	node, ok := fakeLookup(w, r, "id", p.nodes)
+	if !ok {
+		return
+	}
+
+	for id, allocation := range p.allocations {
+		if allocation.NodeID == node.ID {
+			delete(p.allocations, id)
+		}
+	}
+
+	delete(p.nodes, node.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (p *fakePanel) getNodeConfiguration(w http.ResponseWriter, r *http.Request) {
+	node, ok := fakeLookup(w, r, "id", p.nodes)
+	if !ok {
+		return
+	}
+
+	var configuration pterodactyl.NodeConfiguration
+	configuration.UUID = node.UUID
+	configuration.TokenID = "fakeTokenID"
+	configuration.Token = "fakeToken"
+	configuration.API.Host = "0.0.0.0"
+	configuration.API.Port = node.DaemonListen
+	configuration.API.SSL.Enabled = node.Scheme == "https"
+	configuration.API.SSL.Cert = "/etc/letsencrypt/live/" + node.FQDN + "/fullchain.pem"
+	configuration.API.SSL.Key = "/etc/letsencrypt/live/" + node.FQDN + "/privkey.pem"
+	configuration.API.UploadLimit = node.UploadSize
+	configuration.System.Data = node.DaemonBase
+	configuration.System.SFTP.BindPort = node.DaemonSFTP
+	configuration.Remote = p.URL
+
+	// Unlike every other endpoint, the configuration is not wrapped in an object.
+	writeFakeJSON(w, http.StatusOK, configuration)
+}
+
+// Allocations
+
+type fakeAllocationRequest struct {
+	IP    string   `json:"ip"`
+	Alias *string  `json:"alias"`
+	Ports []string `json:"ports"`
+}
+
+func (p *fakePanel) listAllocations(w http.ResponseWriter, r *http.Request) {
+	node, ok := fakeLookup(w, r, "id", p.nodes)
+	if !ok {
+		return
+	}
+
+	allocations := []interface{}{}
+	for _, id := range sortedKeys(p.allocations) {
+		if p.allocations[id].NodeID == node.ID {
+			allocations = append(allocations, p.allocations[id].apiAllocation)
+		}
+	}
+
+	writeFakeList(w, r, "allocation", allocations, "ip", "port")
+}
+
+func (p *fakePanel) createAllocations(w http.ResponseWriter, r *http.Request) {
+	node, ok := fakeLookup(w, r, "id", p.nodes)
+	if !ok {
+		return
+	}
+
+	var body fakeAllocationRequest
+	if !decodeFakeBody(w, r, &body) {
+		return
+	}
+
+	var errs fakeValidationErrors
+	errs.required("ip", body.IP)
+	if len(body.Ports) == 0 {
+		errs.add("ports", "required", "The ports field is required.")
+	}
+	if !errs.write(w) {
+		return
+	}
+
+	if net.ParseIP(body.IP) == nil {
+		writeFakeError(w, http.StatusBadRequest, "DisplayException", "The IP address provided ("+body.IP+") is not valid.")
+		return
+	}
+
+	var ports []int32
+	for _, value := range body.Ports {
+		first, last, isRange := strings.Cut(value, "-")
+		if !isRange {
+			last = first
+		}
+
+		start, err1 := strconv.Atoi(first)
+		end, err2 := strconv.Atoi(last)
+		if err1 != nil || err2 != nil || start > end {
+			writeFakeError(w, http.StatusBadRequest, "InvalidPortMappingException", "The mapping provided for "+value+" was invalid and could not be processed.")
+			return
+		}
+		if end-start > 1000 {
+			writeFakeError(w, http.StatusBadRequest, "TooManyPortsInRangeException", "Adding more than 1000 ports in a single range at once is not supported.")
+			return
+		}
+		if start <= 1024 || end > 65535 {
+			writeFakeError(w, http.StatusBadRequest, "PortOutOfRangeException", "Ports in an allocation must be greater than 1024 and less than or equal to 65535.")
+			return
+		}
+
+		for port := start; port <= end; port++ {
+			ports = append(ports, int32(port))
+		}
+	}
+
+	for _, port := range ports {
+		// The panel silently ignores allocations which already exist.
+		if p.findAllocation(node.ID, body.IP, port) != nil {
+			continue
+		}
+
+		id := p.nextID()
+		p.allocations[id] = &fakeAllocation{
+			apiAllocation: apiAllocation{
+				ID:    id,
+				IP:    body.IP,
+				Alias: body.Alias,
+				Port:  port,
+			},
+			NodeID: node.ID,
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (p *fakePanel) findAllocation(nodeID int32, ip string, port int32) *fakeAllocation {
+	for _, allocation := range p.allocations {
+		if allocation.NodeID == nodeID && allocation.IP == ip && allocation.Port == port {
+			return allocation
+		}
+	}
+
+	return nil
+}
+
+func (p *fakePanel) deleteAllocation(w http.ResponseWriter, r *http.Request) {
+	node, ok := fakeLookup(w, r, "id", p.nodes)
+	if !ok {
+		return
+	}
+
+	allocation, ok := fakeLookup(w, r, "allocation", p.allocations)
+	if !ok {
+		return
+	}
+	if allocation.NodeID != node.ID {
+		writeFakeNotFound(w)
+		return
+	}
+
+	if allocation.Assigned {
+		writeFakeError(w, http.StatusBadRequest, "ServerUsingAllocationException", "Cannot delete an allocation that is currently assigned to a server.")
+		return
+	}
+
+	delete(p.allocations, allocation.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Servers
+
+func (p *fakePanel) getServer(w http.ResponseWriter, r *http.Request) {
+	server, ok := fakeLookup(w, r, "id", p.servers)
+	if !ok {
+		return
+	}
+
+	writeFakeObject(w, http.StatusOK, "server", server)
+}
+
+func (p *fakePanel) createServer(w http.ResponseWriter, r *http.Request) {
+	var body apiCreateServer
+	if !decodeFakeBody(w, r, &body) {
+		return
+	}
+
+	var errs fakeValidationErrors
+	errs.required("name", body.Name)
+	errs.required("docker_image", body.DockerImage)
+	errs.required("startup", body.Startup)
+	if _, ok := p.users[body.User]; !ok {
+		errs.add("user", "exists", "The selected user is invalid.")
+	}
+	allocation, ok := p.allocations[body.Allocation.Default]
+	if !ok {
+		errs.add("allocation.default", "exists", "The selected allocation.default is invalid.")
+	}
+	if !errs.write(w) {
+		return
+	}
+
+	if allocation.Assigned {
+		writeFakeError(w, http.StatusBadRequest, "DisplayException", "The requested allocation is already assigned to a server.")
+		return
+	}
+
+	now := fakeNow()
+	server := &apiServer{
+		ID:            p.nextID(),
+		ExternalID:    body.ExternalID,
+		UUID:          fakeUUID(),
+		Name:          body.Name,
+		Description:   body.Description,
+		Limits:        body.Limits,
+		FeatureLimits: body.FeatureLimits,
+		User:          body.User,
+		Node:          allocation.NodeID,
+		Allocation:    allocation.ID,
+		Nest:          1,
+		Egg:           body.Egg,
+		Container: apiServerContainer{
+			StartupCommand: body.Startup,
+			Image:          body.DockerImage,
+			Installed:      1,
+			Environment:    body.Environment,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	server.Identifier = server.UUID[:8]
+	p.servers[server.ID] = server
+	p.assignAllocation(allocation, server)
+
+	writeFakeObject(w, http.StatusCreated, "server", server)
+}
+
+func (p *fakePanel) updateServerDetails(w http.ResponseWriter, r *http.Request) {
+	server, ok := fakeLookup(w, r, "id", p.servers)
+	if !ok {
+		return
+	}
+
+	var body apiServerDetails
+	if !decodeFakeBody(w, r, &body) {
+		return
+	}
+
+	server.Name = body.Name
+	server.Description = body.Description
+	server.ExternalID = body.ExternalID
+	server.User = body.User
+	server.UpdatedAt = fakeNow()
+
+	writeFakeObject(w, http.StatusOK, "server", server)
+}
+
+// updateServerBuild assigns and takes allocations the way the panel does:
+// added allocations must be free and on the node of the server, and the
+// default allocation must be assigned to the server afterwards.
+func (p *fakePanel) updateServerBuild(w http.ResponseWriter, r *http.Request) {
+	server, ok := fakeLookup(w, r, "id", p.servers)
+	if !ok {
+		return
+	}
+
+	var body apiServerBuild
+	if !decodeFakeBody(w, r, &body) {
+		return
+	}
+
+	for _, id := range body.AddAllocations {
+		// The panel silently skips allocations it cannot assign.
+		if allocation, ok := p.allocations[id]; ok && allocation.NodeID == server.Node && !allocation.Assigned {
+			p.assignAllocation(allocation, server)
+		}
+	}
+
+	for _, id := range body.RemoveAllocations {
+		if id == body.Allocation {
+			writeFakeError(w, http.StatusBadRequest, "DisplayException", "You are attempting to delete the default allocation for this server but there is no fallback allocation to use.")
+			return
+		}
+		if allocation, ok := p.allocations[id]; ok && p.assignedTo(allocation, server) {
+			p.releaseAllocation(allocation)
+		}
+	}
+
+	if allocation, ok := p.allocations[body.Allocation]; !ok || !p.assignedTo(allocation, server) {
+		writeFakeError(w, http.StatusBadRequest, "DisplayException", "The requested default allocation is not currently assigned to this server.")
+		return
+	}
+
+	server.Allocation = body.Allocation
+	server.Limits = body.Limits
+	server.Limits.OOMDisabled = body.OOMDisabled
+	server.FeatureLimits = body.FeatureLimits
+	server.UpdatedAt = fakeNow()
+
+	writeFakeObject(w, http.StatusOK, "server", server)
+}
+
+func (p *fakePanel) updateServerStartup(w http.ResponseWriter, r *http.Request) {
+	server, ok := fakeLookup(w, r, "id", p.servers)
+	if !ok {
+		return
+	}
+
+	var body apiServerStartup
+	if !decodeFakeBody(w, r, &body) {
+		return
+	}
+
+	server.Container.StartupCommand = body.Startup
+	server.Container.Image = body.Image
+	server.Container.Environment = body.Environment
+	server.Egg = body.Egg
+	server.UpdatedAt = fakeNow()
+
+	writeFakeObject(w, http.StatusOK, "server", server)
+}
+
+func (p *fakePanel) deleteServer(w http.ResponseWriter, r *http.Request) {
+	server, ok := fakeLookup(w, r, "id", p.servers)
+	if !ok {
+		return
+	}
+
+	for _, allocation := range p.allocations {
+		if p.assignedTo(allocation, server) {
+			p.releaseAllocation(allocation)
+		}
+	}
+
+	delete(p.servers, server.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (p *fakePanel) assignAllocation(allocation *fakeAllocation, server *apiServer) {
+	allocation.Assigned = true
+	allocation.Relationships.Server.Attributes = &apiAllocationServer{
+		ID:         server.ID,
+		Identifier: server.Identifier,
+	}
+}
+
+func (p *fakePanel) releaseAllocation(allocation *fakeAllocation) {
+	allocation.Assigned = false
+	allocation.Relationships.Server.Attributes = nil
+}
+
+func (p *fakePanel) assignedTo(allocation *fakeAllocation, server *apiServer) bool {
+	return allocation.Relationships.Server.Attributes != nil && allocation.Relationships.Server.Attributes.ID == server.ID
+}
+
+// Helpers shared by the endpoints
+
+func sortedKeys[V any](m map[int32]V) []int32 {
+	keys := make([]int32, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	return keys
+}
+
+// fakeLookup returns the object of objects with the ID in the path value
+// name, writing a not found error if there is none.
+func fakeLookup[V any](w http.ResponseWriter, r *http.Request, name string, objects map[int32]*V) (*V, bool) {
+	id, err := strconv.ParseInt(r.PathValue(name), 10, 32)
+	if err != nil {
+		writeFakeNotFound(w)
+		return nil, false
+	}
+
+	object, ok := objects[int32(id)]
+	if !ok {
+		writeFakeNotFound(w)
+		return nil, false
+	}
+
+	return object, true
+}
+
+func decodeFakeBody(w http.ResponseWriter, r *http.Request, body interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		writeFakeError(w, http.StatusBadRequest, "BadRequestHttpException", "The JSON data passed in the request appears to be malformed: "+err.Error())
+		return false
+	}
+
+	return true
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/vnd.pterodactyl.v1+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeFakeObject(w http.ResponseWriter, status int, object string, attributes interface{}) {
+	writeFakeJSON(w, status, map[string]interface{}{
+		"object":     object,
+		"attributes": attributes,
+	})
+}
+
+// writeFakeList writes a page of items, filtered by the filter[...] query
+// parameters on the allowed JSON fields and paginated by page and per_page.
+func writeFakeList(w http.ResponseWriter, r *http.Request, object string, items []interface{}, filters ...string) {
+	query := r.URL.Query()
+
+	for key, values := range query {
+		field, ok := strings.CutPrefix(key, "filter[")
+		if !ok {
+			continue
+		}
+		field = strings.TrimSuffix(field, "]")
+
+		allowed := false
+		for _, filter := range filters {
+			allowed = allowed || filter == field
+		}
+		if !allowed {
+			writeFakeError(w, http.StatusBadRequest, "InvalidFilterQuery", "Requested filter(s) `"+field+"` are not allowed. Allowed filter(s) are `"+strings.Join(filters, ", ")+"`.")
+			return
+		}
+
+		filtered := items[:0:0]
+		for _, item := range items {
+			if fakeFieldContains(item, field, values[0]) {
+				filtered = append(filtered, item)
+			}
+		}
+		items = filtered
+	}
+
+	perPage := fakePanelPerPage
+	if value, err := strconv.Atoi(query.Get("per_page")); err == nil && value > 0 {
+		perPage = value
+	}
+	page := 1
+	if value, err := strconv.Atoi(query.Get("page")); err == nil && value > 0 {
+		page = value
+	}
+
+	totalPages := max(1, (len(items)+perPage-1)/perPage)
+	start := min(len(items), (page-1)*perPage)
+	end := min(len(items), start+perPage)
+
+	data := make([]map[string]interface{}, 0, end-start)
+	for _, item := range items[start:end] {
+		data = append(data, map[string]interface{}{
+			"object":     object,
+			"attributes": item,
+		})
+	}
+
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{
+		"object": "list",
+		"data":   data,
+		"meta": map[string]interface{}{
+			"pagination": map[string]interface{}{
+				"total":        len(items),
+				"count":        len(data),
+				"per_page":     perPage,
+				"current_page": page,
+				"total_pages":  totalPages,
+				"links":        map[string]interface{}{},
+			},
+		},
+	})
+}
+
+// fakeFieldContains reports whether the JSON field of item contains value,
+// as the partial filters of the panel do.
+func fakeFieldContains(item interface{}, field, value string) bool {
+	b, _ := json.Marshal(item)
+	var fields map[string]interface{}
+	_ = json.Unmarshal(b, &fields)
+
+	actual, ok := fields[field]
+	if !ok || actual == nil {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(fmt.Sprint(actual)), strings.ToLower(value))
+}
+
+func writeFakeError(w http.ResponseWriter, status int, code, detail string) {
+	writeFakeJSON(w, status, map[string]interface{}{
+		"errors": []map[string]interface{}{{
+			"code":   code,
+			"status": strconv.Itoa(status),
+			"detail": detail,
+		}},
+	})
+}
+
+func writeFakeNotFound(w http.ResponseWriter) {
+	writeFakeError(w, http.StatusNotFound, "NotFoundHttpException", "The requested resource could not be found on the server.")
+}
+
+// fakeValidationErrors collects the validation errors of a request, written
+// as a single 422 response like the panel does.
+type fakeValidationErrors []map[string]interface{}
+
+func (e *fakeValidationErrors) add(field, rule, detail string) {
+	*e = append(*e, map[string]interface{}{
+		"code":   "ValidationException",
+		"status": strconv.Itoa(http.StatusUnprocessableEntity),
+		"detail": detail,
+		"meta": map[string]interface{}{
+			"source_field": field,
+			"rule":         rule,
+		},
+	})
+}
+
+func (e *fakeValidationErrors) required(field, value string) {
+	if value == "" {
+		e.add(field, "required", "The "+strings.ReplaceAll(field, "_", " ")+" field is required.")
+	}
+}
+
+// write writes the validation errors, returning whether there were none.
+func (e fakeValidationErrors) write(w http.ResponseWriter) bool {
+	if len(e) == 0 {
+		return true
+	}
+
+	writeFakeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{"errors": e})
+	return false
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// importIDLookup resolves the import IDs of a resource to the ID of the
+// object in the panel. Import IDs are prefixed with the attribute they are
+// looked up by, e.g. id:12 or name:fra-1.
+type importIDLookup struct {
+	// Object is the name of the objects in diagnostics, e.g. node.
+	Object string
+	// Endpoint lists the objects, relative to the host of the panel.
+	Endpoint string
+	// DefaultKey is assumed for import IDs without a prefix.
+	DefaultKey string
+	// Keys are the attributes the objects can be looked up by besides
+	// their ID. The panel must support a filter for each of them.
+	Keys []string
+	// FoldKeys are the keys compared regardless of case, like emails.
+	FoldKeys []string
+}
+
+// apiImportObject - Any object of a list returned by the application API
+type apiImportObject struct {
+	Object     string                     `json:"object"`
+	Attributes map[string]json.RawMessage `json:"attributes"`
+}
+
+// resolve returns the ID of the object identified by importID. Errors are
+// added to diags, in which case ok is false.
+func (l importIDLookup) resolve(ctx context.Context, c *pterodactyl.Client, diags *diag.Diagnostics, importID string) (id int32, ok bool) {
+	key, value, found := strings.Cut(importID, ":")
+	if !found {
+		key, value = l.DefaultKey, importID
+	}
+
+	if key == "id" {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || parsed < 1 {
+			diags.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected a numeric %s ID, got: %q", l.Object, value),
+			)
+			return 0, false
+		}
+		return int32(parsed), true
+	}
+
+	known := false
+	for _, k := range l.Keys {
+		known = known || k == key
+	}
+	if !known || value == "" {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID like %s, got: %q", l.examples(), importID),
+		)
+		return 0, false
+	}
+
+	// The filters of the panel match partially, so the objects are
+	// compared to the value again.
+	query := url.Values{}
+	query.Set("filter["+key+"]", value)
+	objects, err := getAllPages[apiImportObject](ctx, c, fmt.Sprintf("%s%s?%s", c.HostURL, l.Endpoint, query.Encode()))
+	if err != nil {
+		diags.AddError(
+			"Error Importing Pterodactyl "+titleCase(l.Object),
+			fmt.Sprintf("Could not look up the %s with %s %q: %s", l.Object, key, value, err.Error()),
+		)
+		return 0, false
+	}
+
+	equal := func(a, b string) bool { return a == b }
+	for _, k := range l.FoldKeys {
+		if k == key {
+			equal = strings.EqualFold
+		}
+	}
+
+	var ids []int32
+	for _, object := range objects {
+		var attribute string
+		if json.Unmarshal(object.Attributes[key], &attribute) != nil || !equal(attribute, value) {
+			continue
+		}
+
+		var objectID int32
+		if err := json.Unmarshal(object.Attributes["id"], &objectID); err != nil {
+			diags.AddError(
+				"Error Importing Pterodactyl "+titleCase(l.Object),
+				fmt.Sprintf("Could not read the ID of the %s with %s %q: %s", l.Object, key, value, err.Error()),
+			)
+			return 0, false
+		}
+		ids = append(ids, objectID)
+	}
+
+	switch len(ids) {
+	case 0:
+		diags.AddError(
+			"Error Importing Pterodactyl "+titleCase(l.Object),
+			fmt.Sprintf("Could not find a %s with %s %q", l.Object, key, value),
+		)
+		return 0, false
+	case 1:
+		return ids[0], true
+	default:
+		diags.AddError(
+			"Error Importing Pterodactyl "+titleCase(l.Object),
+			fmt.Sprintf("Found %d %ss with %s %q, import it by its ID instead, e.g. id:%d", len(ids), l.Object, key, value, ids[0]),
+		)
+		return 0, false
+	}
+}
+
+// examples lists the accepted import IDs for diagnostics.
+func (l importIDLookup) examples() string {
+	examples := []string{"id:12"}
+	for _, key := range l.Keys {
+		examples = append(examples, key+":<"+key+">")
+	}
+	return strings.Join(examples, ", ")
+}
+
+// titleCase returns s with the first letter of every word in upper case.
+func titleCase(s string) string {
+	words := strings.Fields(s)
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestImportIDLookup(t *testing.T) {
+	ctx := context.Background()
+	client := newReplayClients(t, "import_id_lookup").Application
+
+	// The lookups requesting the panel run in the order of the fixture.
+	testCases := []struct {
+		lookup   importIDLookup
+		importID string
+		expected int32
+		invalid  bool
+	}{
+		{lookup: nodeImportIDs, importID: "12", expected: 12},
+		{lookup: nodeImportIDs, importID: "id:12", expected: 12},
+		{lookup: nodeImportIDs, importID: "id:fra-1", invalid: true},
+		{lookup: nodeImportIDs, importID: "fra-1", invalid: true},
+		{lookup: nodeImportIDs, importID: "fqdn:fra-1.example.com", invalid: true},
+		{lookup: nodeImportIDs, importID: "name:", invalid: true},
+		// The filters of the panel match partially, fra-10 is ignored.
+		{lookup: nodeImportIDs, importID: "name:fra-1", expected: 2},
+		{lookup: nodeImportIDs, importID: "name:ams-1", invalid: true},
+		{lookup: userImportIDs, importID: "terraformer", expected: 7},
+		// Ambiguous lookups ask for the ID instead.
+		{lookup: userImportIDs, importID: "external_id:sso:1042", invalid: true},
+		// Emails match regardless of case, like in the users data source.
+		{lookup: userImportIDs, importID: "email:TerraFormer@Example.com", expected: 7},
+	}
+
+	for _, testCase := range testCases {
+		var diags diag.Diagnostics
+		id, ok := testCase.lookup.resolve(ctx, client, &diags, testCase.importID)
+		if testCase.invalid {
+			if ok || !diags.HasError() {
+				t.Errorf("%s: expected an error, got %d", testCase.importID, id)
+			}
+			continue
+		}
+		if !ok || diags.HasError() {
+			t.Errorf("%s: unexpected error: %v", testCase.importID, diags)
+			continue
+		}
+		if id != testCase.expected {
+			t.Errorf("%s: resolved to %d, expected %d", testCase.importID, id, testCase.expected)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listQuery returns the query of a list request filtering by the filters
+// that are set, keyed by the attribute the panel filters, e.g. email, and
+// sorting by sort if it is set.
+func listQuery(filters map[string]types.String, sort types.String) url.Values {
+	query := url.Values{}
+	for key, filter := range filters {
+		if !filter.IsNull() {
+			query.Set("filter["+key+"]", filter.ValueString())
+		}
+	}
+	if !sort.IsNull() {
+		query.Set("sort", sort.ValueString())
+	}
+	return query
+}
+
+// matchesFilter reports whether value equals filter, or filter is not set.
+// The filters of the panel match a part of the attribute, so the objects it
+// returns are compared to the filters again.
+func matchesFilter(filter types.String, value string) bool {
+	return filter.IsNull() || filter.ValueString() == value
+}
+
+// matchesFilterFold is matchesFilter for attributes the panel compares
+// without regard to case, like emails.
+func matchesFilterFold(filter types.String, value string) bool {
+	return filter.IsNull() || strings.EqualFold(filter.ValueString(), value)
+}
+
+// sortValues returns the values of the sort attribute for the attributes the
+// panel can sort a list by, ascending and descending with a leading minus.
+func sortValues(attributes ...string) []string {
+	values := make([]string, 0, 2*len(attributes))
+	for _, attribute := range attributes {
+		values = append(values, attribute, "-"+attribute)
+	}
+	return values
+}
@@ -111,9 +111,11 @@ func (d *locationDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	var location pterodactyl.Location
 
+	client := withContext(ctx, d.client)
+
 	if !state.ID.IsNull() {
 		var err error
-		location, err = d.client.GetLocation(state.ID.ValueInt32())
+		location, err = client.GetLocation(state.ID.ValueInt32())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read Pterodactyl Location",
@@ -122,7 +124,7 @@ func (d *locationDataSource) Read(ctx context.Context, req datasource.ReadReques
 			return
 		}
 	} else if !state.Short.IsNull() {
-		locations, err := d.client.GetLocations()
+		locations, err := client.GetLocations()
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read Pterodactyl Locations",
@@ -138,7 +140,7 @@ func (d *locationDataSource) Read(ctx context.Context, req datasource.ReadReques
 			break
 		}
 	} else if !state.Long.IsNull() {
-		locations, err := d.client.GetLocations()
+		locations, err := client.GetLocations()
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read Pterodactyl Locations",
@@ -186,15 +188,15 @@ func (d *locationDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*pterodactyl.Client)
+	clients, ok := req.ProviderData.(*pterodactylClients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *pterodactyl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = clients.Application
 }
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestLocationDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewLocationDataSource(), "location_data_source_read", map[string]tftypes.Value{
+		"short": tftypes.NewValue(tftypes.String, "us.nyc"),
+	})
+
+	var location locationDataSourceModel
+	replayStateGet(t, state, &location)
+
+	replayCheck(t, "id", location.ID, types.Int32Value(4))
+	replayCheck(t, "short", location.Short, types.StringValue("us.nyc"))
+	replayCheck(t, "long", location.Long, types.StringValue("New York, United States"))
+	replayCheck(t, "created_at", location.CreatedAt, types.StringValue("2024-02-20T17:45:31Z"))
+	replayCheck(t, "updated_at", location.UpdatedAt, types.StringValue("2024-02-20T17:45:31Z"))
+}
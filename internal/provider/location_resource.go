@@ -7,12 +7,14 @@ import (
 	"time"
 
 	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -34,11 +36,23 @@ type locationResource struct {
 
 // locationResourceModel maps the resource schema data.
 type locationResourceModel struct {
-	ID        types.Int32  `tfsdk:"id"`
-	Short     types.String `tfsdk:"short"`
-	Long      types.String `tfsdk:"long"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID        types.Int32    `tfsdk:"id"`
+	Short     types.String   `tfsdk:"short"`
+	Long      types.String   `tfsdk:"long"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	UpdatedAt types.String   `tfsdk:"updated_at"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// locationAPIFields maps the fields of panel validation errors to the location schema.
+var locationAPIFields = rootAPIFields("short", "long")
+
+// locationImportIDs are the import IDs of locations.
+var locationImportIDs = importIDLookup{
+	Object:     "location",
+	Endpoint:   "/api/application/locations",
+	DefaultKey: "id",
+	Keys:       []string{"short"},
 }
 
 // Metadata returns the resource type name.
@@ -47,7 +61,7 @@ func (r *locationResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *locationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *locationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The Pterodactyl location resource allows Terraform to manage locations in the Pterodactyl Panel API.",
 		Attributes: map[string]schema.Attribute{
@@ -78,6 +92,14 @@ func (r *locationResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -91,18 +113,29 @@ func (r *locationResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create partial location
 	partialLocation := pterodactyl.PartialLocation{
 		Short: plan.Short.ValueString(),
 		Long:  plan.Long.ValueString(),
 	}
 
+	client := withContext(ctx, r.client)
+
 	// Create new location
-	location, err := r.client.CreateLocation(partialLocation)
+	location, err := client.CreateLocation(partialLocation)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics, locationAPIFields, err,
 			"Error creating location",
-			"Could not create location, unexpected error: "+err.Error(),
+			contextErrorDetail(ctx, "creating the location", "Could not create location, unexpected error: "+err.Error()),
 		)
 		return
 	}
@@ -130,12 +163,30 @@ func (r *locationResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client := withContext(ctx, r.client)
+
 	// Get refreshed location value from Pterodactyl
-	location, err := r.client.GetLocation(state.ID.ValueInt32())
+	location, err := client.GetLocation(state.ID.ValueInt32())
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Pterodactyl location not found, removing it from state", map[string]interface{}{
+			"id": state.ID.ValueInt32(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Pterodactyl Location",
-			"Could not read Pterodactyl location ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error(),
+			contextErrorDetail(ctx, "reading the location", "Could not read Pterodactyl location ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error()),
 		)
 		return
 	}
@@ -163,18 +214,29 @@ func (r *locationResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Create partial location
 	var partialLocation = pterodactyl.PartialLocation{
 		Short: plan.Short.ValueString(),
 		Long:  plan.Long.ValueString(),
 	}
 
+	client := withContext(ctx, r.client)
+
 	// Update existing location
-	location, err := r.client.UpdateLocation(plan.ID.ValueInt32(), partialLocation)
+	location, err := client.UpdateLocation(plan.ID.ValueInt32(), partialLocation)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics, locationAPIFields, err,
 			"Error Updating Pterodactyl Location",
-			"Could not update location, unexpected error: "+err.Error(),
+			contextErrorDetail(ctx, "updating the location", "Could not update location, unexpected error: "+err.Error()),
 		)
 		return
 	}
@@ -201,12 +263,23 @@ func (r *locationResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client := withContext(ctx, r.client)
+
 	// Delete existing location
-	err := r.client.DeleteLocation(state.ID.ValueInt32())
-	if err != nil {
+	err := client.DeleteLocation(state.ID.ValueInt32())
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Pterodactyl Location",
-			"Could not delete location, unexpected error: "+err.Error(),
+			contextErrorDetail(ctx, "deleting the location", "Could not delete location, unexpected error: "+err.Error()),
 		)
 		return
 	}
@@ -220,30 +293,29 @@ func (r *locationResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*pterodactyl.Client)
+	clients, ok := req.ProviderData.(*pterodactylClients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *pterodactyl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = clients.Application
 }
 
 func (r *locationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	locationID, err := strconv.ParseInt(req.ID, 10, 32)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error importing state",
-			"Couldn't convert id to int",
-		)
+	locationID, ok := locationImportIDs.resolve(ctx, r.client, &resp.Diagnostics, req.ID)
+	if !ok {
+		return
 	}
 
-	location, err := r.client.GetLocation(int32(locationID))
+	client := withContext(ctx, r.client)
+
+	location, err := client.GetLocation(locationID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Importing Pterodactyl Location",
@@ -261,6 +333,11 @@ func (r *locationResource) ImportState(ctx context.Context, req resource.ImportS
 		UpdatedAt: types.StringValue(location.UpdatedAt.Format(time.RFC3339)),
 	}
 
+	state.Timeouts = importTimeouts(ctx, resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	diags := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLocationResource(t *testing.T) {
+	panel := newFakePanel(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             panel.testAccCheckDestroyed,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(panel) + testAccLocationResourceConfig("de-fra", "Frankfurt, Germany"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pterodactyl_location.test", "short", "de-fra"),
+					resource.TestCheckResourceAttr("pterodactyl_location.test", "long", "Frankfurt, Germany"),
+					resource.TestCheckResourceAttrSet("pterodactyl_location.test", "id"),
+					resource.TestCheckResourceAttrSet("pterodactyl_location.test", "created_at"),
+					resource.TestCheckResourceAttrSet("pterodactyl_location.test", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pterodactyl_location.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by the short code
+			{
+				ResourceName:      "pterodactyl_location.test",
+				ImportState:       true,
+				ImportStateId:     "short:de-fra",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(panel) + testAccLocationResourceConfig("de-ber", "Berlin, Germany"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pterodactyl_location.test", "short", "de-ber"),
+					resource.TestCheckResourceAttr("pterodactyl_location.test", "long", "Berlin, Germany"),
+					// Delete the location in the panel for the next step
+					testAccDeleteInPanel(panel, panel.locations, "pterodactyl_location.test"),
+				),
+				// The refresh after the checks finds the location gone
+				ExpectNonEmptyPlan: true,
+			},
+			// Re-create testing after the location has been deleted in the panel
+			{
+				Config: testAccProviderConfig(panel) + testAccLocationResourceConfig("de-ber", "Berlin, Germany"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pterodactyl_location.test", "short", "de-ber"),
+				),
+			},
+			// Validation errors of the panel are reported on the attribute
+			{
+				Config: testAccProviderConfig(panel) + testAccLocationResourceConfig("de-ber", "Berlin, Germany") + `
+resource "pterodactyl_location" "duplicate" {
+  short = "de-ber"
+  long  = "Berlin, Germany"
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)Error creating location.*The\s+short\s+has\s+already\s+been\s+taken`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccLocationResourceConfig(short, long string) string {
+	return fmt.Sprintf(`
+resource "pterodactyl_location" "test" {
+  short = %[1]q
+  long  = %[2]q
+}
+`, short, long)
+}
+
+func TestLocationResourceRead(t *testing.T) {
+	state := replayResourceRead(t, NewLocationResource(), "location_resource_read", map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.Number, 3),
+	})
+
+	var location locationResourceModel
+	replayStateGet(t, state, &location)
+
+	replayCheck(t, "id", location.ID, types.Int32Value(3))
+	replayCheck(t, "short", location.Short, types.StringValue("de.fra"))
+	replayCheck(t, "long", location.Long, types.StringValue("Frankfurt, Germany"))
+	replayCheck(t, "created_at", location.CreatedAt, types.StringValue("2024-01-02T10:11:12Z"))
+	replayCheck(t, "updated_at", location.UpdatedAt, types.StringValue("2024-03-11T09:14:02Z"))
+}
+
+func TestLocationResourceReadNotFound(t *testing.T) {
+	state := replayResourceRead(t, NewLocationResource(), "location_resource_read_not_found", map[string]tftypes.Value{
+		"id":    tftypes.NewValue(tftypes.Number, 3),
+		"short": tftypes.NewValue(tftypes.String, "de.fra"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Errorf("expected the location to be removed from the state, got %s", state.Raw)
+	}
+}
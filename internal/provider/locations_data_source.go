@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &locationsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &locationsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &locationsDataSource{}
+)
+
+// NewLocationsDataSource is a helper function to simplify the provider implementation.
+func NewLocationsDataSource() datasource.DataSource {
+	return &locationsDataSource{}
+}
+
+// locationsDataSource is the data source implementation.
+type locationsDataSource struct {
+	client *pterodactyl.Client
+}
+
+// locationsDataSourceModel maps the data source schema data.
+type locationsDataSourceModel struct {
+	ShortPrefix types.String `tfsdk:"short_prefix"`
+	ShortRegex  types.String `tfsdk:"short_regex"`
+	Locations   []Location   `tfsdk:"locations"`
+}
+
+// Location schema data.
+type Location struct {
+	ID        types.Int32   `tfsdk:"id"`
+	Short     types.String  `tfsdk:"short"`
+	Long      types.String  `tfsdk:"long"`
+	NodeIDs   []types.Int32 `tfsdk:"node_ids"`
+	CreatedAt types.String  `tfsdk:"created_at"`
+	UpdatedAt types.String  `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *locationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_locations"
+}
+
+// Schema defines the schema for the data source.
+func (d *locationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl locations data source allows Terraform to read locations from the Pterodactyl API.",
+		Attributes: map[string]schema.Attribute{
+			"short_prefix": schema.StringAttribute{
+				Description: "Only return the locations with a short name starting with this prefix, e.g. eu-.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"short_regex": schema.StringAttribute{
+				Description: "Only return the locations with a short name matching this regular expression in the RE2 syntax, e.g. ^(de|fr)-.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"locations": schema.ListNestedAttribute{
+				Description: "The list of locations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Description: "The ID of the location.",
+							Computed:    true,
+						},
+						"short": schema.StringAttribute{
+							Description: "The short name of the location.",
+							Computed:    true,
+						},
+						"long": schema.StringAttribute{
+							Description: "The long name of the location.",
+							Computed:    true,
+						},
+						"node_ids": schema.ListAttribute{
+							Description: "The IDs of the nodes in the location.",
+							ElementType: types.Int32Type,
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The creation date of the location.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The last update date of the location.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the regular expression before reading the locations.
+func (d *locationsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var shortRegex types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("short_regex"), &shortRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || shortRegex.IsNull() || shortRegex.IsUnknown() {
+		return
+	}
+
+	_, err := regexp.Compile(shortRegex.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("short_regex"),
+			"Invalid Regular Expression",
+			err.Error(),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *locationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state locationsDataSourceModel
+
+	// Get the filters from the request
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var shortRegex *regexp.Regexp
+	if !state.ShortRegex.IsNull() {
+		var err error
+		shortRegex, err = regexp.Compile(state.ShortRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("short_regex"),
+				"Invalid Regular Expression",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// The panel filters by a part of the short name, which narrows the
+	// locations down before matching the prefix.
+	query := url.Values{}
+	query.Set("include", "nodes")
+	if !state.ShortPrefix.IsNull() {
+		query.Set("filter[short]", state.ShortPrefix.ValueString())
+	}
+
+	locations, err := getLocations(ctx, d.client, query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Pterodactyl Locations",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Locations = make([]Location, 0, len(locations))
+	for _, location := range locations {
+		if !strings.HasPrefix(location.Short, state.ShortPrefix.ValueString()) {
+			continue
+		}
+		if shortRegex != nil && !shortRegex.MatchString(location.Short) {
+			continue
+		}
+
+		nodeIDs := location.nodeIDs()
+		locationState := Location{
+			ID:        types.Int32Value(location.ID),
+			Short:     types.StringValue(location.Short),
+			Long:      types.StringValue(location.Long),
+			NodeIDs:   make([]types.Int32, len(nodeIDs)),
+			CreatedAt: types.StringValue(location.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt: types.StringValue(location.UpdatedAt.Format(time.RFC3339)),
+		}
+		for i, nodeID := range nodeIDs {
+			locationState.NodeIDs[i] = types.Int32Value(nodeID)
+		}
+		state.Locations = append(state.Locations, locationState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *locationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Application
+}
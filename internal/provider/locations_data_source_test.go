@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestLocationsDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewLocationsDataSource(), "locations_data_source_read", map[string]tftypes.Value{
+		"short_prefix": tftypes.NewValue(tftypes.String, "eu-"),
+		"short_regex":  tftypes.NewValue(tftypes.String, "-(west|north)$"),
+	})
+
+	var locations locationsDataSourceModel
+	replayStateGet(t, state, &locations)
+
+	// The panel also returns us-eu-1 for filter[short], and the regular
+	// expression excludes eu-south.
+	if len(locations.Locations) != 2 {
+		t.Fatalf("read %d locations, expected 2", len(locations.Locations))
+	}
+
+	west, north := locations.Locations[0], locations.Locations[1]
+	replayCheck(t, "locations.0.id", west.ID, types.Int32Value(3))
+	replayCheck(t, "locations.0.short", west.Short, types.StringValue("eu-west"))
+	replayCheck(t, "locations.0.long", west.Long, types.StringValue("Frankfurt, Germany"))
+	if len(west.NodeIDs) != 2 {
+		t.Fatalf("locations.0.node_ids has %d elements, expected 2", len(west.NodeIDs))
+	}
+	replayCheck(t, "locations.0.node_ids.0", west.NodeIDs[0], types.Int32Value(2))
+	replayCheck(t, "locations.0.node_ids.1", west.NodeIDs[1], types.Int32Value(5))
+	replayCheck(t, "locations.0.created_at", west.CreatedAt, types.StringValue("2024-01-02T10:11:12Z"))
+
+	replayCheck(t, "locations.1.short", north.Short, types.StringValue("eu-north"))
+	if north.NodeIDs == nil || len(north.NodeIDs) != 0 {
+		t.Errorf("locations.1.node_ids is %v, expected an empty list", north.NodeIDs)
+	}
+}
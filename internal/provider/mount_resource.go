@@ -0,0 +1,537 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &mountResource{}
+	_ resource.ResourceWithConfigure   = &mountResource{}
+	_ resource.ResourceWithImportState = &mountResource{}
+)
+
+// NewMountResource is a helper function to simplify the provider implementation.
+func NewMountResource() resource.Resource {
+	return &mountResource{}
+}
+
+// mountResource is the resource implementation.
+type mountResource struct {
+	client *pterodactyl.Client
+}
+
+// mountResourceModel maps the resource schema data.
+type mountResourceModel struct {
+	ID            types.Int32    `tfsdk:"id"`
+	UUID          types.String   `tfsdk:"uuid"`
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	Source        types.String   `tfsdk:"source"`
+	Target        types.String   `tfsdk:"target"`
+	ReadOnly      types.Bool     `tfsdk:"read_only"`
+	UserMountable types.Bool     `tfsdk:"user_mountable"`
+	NodeIDs       []types.Int32  `tfsdk:"node_ids"`
+	EggIDs        []types.Int32  `tfsdk:"egg_ids"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// mountAPIFields maps the fields of panel validation errors to the mount schema.
+var mountAPIFields = apiFieldPaths{
+	"name":           path.Root("name"),
+	"description":    path.Root("description"),
+	"source":         path.Root("source"),
+	"target":         path.Root("target"),
+	"read_only":      path.Root("read_only"),
+	"user_mountable": path.Root("user_mountable"),
+	"nodes":          path.Root("node_ids"),
+	"eggs":           path.Root("egg_ids"),
+}
+
+// mountImportIDs are the import IDs of mounts.
+var mountImportIDs = importIDLookup{
+	Object:     "mount",
+	Endpoint:   "/api/application/mounts",
+	DefaultKey: "id",
+	Keys:       []string{"uuid", "name"},
+}
+
+// Metadata returns the resource type name.
+func (r *mountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mount"
+}
+
+// Schema defines the schema for the resource.
+func (r *mountResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl mount resource allows Terraform to manage mounts in the Pterodactyl Panel API. " +
+			"Mounts share a directory of the nodes with the servers of the attached eggs. " +
+			"They can only be managed through the API of Pelican, the Pterodactyl Panel 1.x does not expose them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				Description: "The ID of the mount.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "The UUID of the mount.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the mount.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the mount.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"source": schema.StringAttribute{
+				Description: "The path on the node that is mounted into the servers.",
+				Required:    true,
+			},
+			"target": schema.StringAttribute{
+				Description: "The path the mount is available at inside the servers.",
+				Required:    true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Is the mount read only inside the servers?",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"user_mountable": schema.BoolAttribute{
+				Description: "Can users mount it to their servers themselves?",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"node_ids": schema.SetAttribute{
+				Description: "The IDs of the nodes the mount is available on.",
+				ElementType: types.Int32Type,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.Int32Type, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueInt32sAre(int32validator.AtLeast(1)),
+				},
+			},
+			"egg_ids": schema.SetAttribute{
+				Description: "The IDs of the eggs whose servers can use the mount.",
+				ElementType: types.Int32Type,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.Int32Type, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueInt32sAre(int32validator.AtLeast(1)),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *mountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan mountResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// The attachments are made after the mount exists
+	nodeIDs := int32Values(plan.NodeIDs)
+	eggIDs := int32Values(plan.EggIDs)
+
+	// Create new mount
+	mount, err := createMount(ctx, r.client, plan.toAPI())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, mountAPIFields, err,
+			"Error creating mount",
+			contextErrorDetail(ctx, "creating the mount", "Could not create mount, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	// Save the mount before attaching it, so a failed attachment does not
+	// leave an untracked mount behind
+	plan.fromAPI(mount)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Attach the mount to the planned nodes and eggs
+	if !r.reconcileAttachments(ctx, &resp.Diagnostics, mount, nodeIDs, eggIDs) {
+		return
+	}
+
+	// Fetch the mount with its attachments
+	mount, err = getMount(ctx, r.client, mount.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating mount",
+			contextErrorDetail(ctx, "reading the mount", "Could not fetch mount, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.fromAPI(mount)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *mountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state mountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed mount value from Pterodactyl
+	mount, err := getMount(ctx, r.client, state.ID.ValueInt32())
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Pterodactyl mount not found, removing it from state", map[string]interface{}{
+			"id": state.ID.ValueInt32(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pterodactyl Mount",
+			contextErrorDetail(ctx, "reading the mount", "Could not read Pterodactyl mount ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error()),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.fromAPI(mount)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *mountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan mountResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing mount
+	_, err := updateMount(ctx, r.client, plan.ID.ValueInt32(), plan.toAPI())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, mountAPIFields, err,
+			"Error Updating Pterodactyl Mount",
+			contextErrorDetail(ctx, "updating the mount", "Could not update mount, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	// Check which nodes and eggs need to be attached and which detached
+	mount, err := getMount(ctx, r.client, plan.ID.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Pterodactyl Mount",
+			contextErrorDetail(ctx, "reading the mount", "Could not update mount attachments: "+err.Error()),
+		)
+		return
+	}
+
+	if !r.reconcileAttachments(ctx, &resp.Diagnostics, mount, int32Values(plan.NodeIDs), int32Values(plan.EggIDs)) {
+		return
+	}
+
+	// Fetch the mount with its attachments
+	mount, err = getMount(ctx, r.client, plan.ID.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Pterodactyl Mount",
+			contextErrorDetail(ctx, "reading the mount", "Could not fetch mount, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	// Update resource state with updated values
+	plan.fromAPI(mount)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *mountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state mountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing mount, the panel removes its attachments with it
+	err := deleteMount(ctx, r.client, state.ID.ValueInt32())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Pterodactyl Mount",
+			contextErrorDetail(ctx, "deleting the mount", "Could not delete mount, unexpected error: "+err.Error()),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Application
+}
+
+func (r *mountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	mountID, ok := mountImportIDs.resolve(ctx, r.client, &resp.Diagnostics, req.ID)
+	if !ok {
+		return
+	}
+
+	mount, err := getMount(ctx, r.client, mountID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Pterodactyl Mount",
+			"Could not import mount: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	var state mountResourceModel
+	state.fromAPI(mount)
+
+	state.Timeouts = importTimeouts(ctx, resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// reconcileAttachments attaches the mount to the planned nodes and eggs it is
+// not attached to yet and detaches it from the others. It returns false after
+// adding an error to diags.
+func (r *mountResource) reconcileAttachments(ctx context.Context, diags *diag.Diagnostics, mount apiMount, nodeIDs []int32, eggIDs []int32) bool {
+	return r.reconcileRelation(ctx, diags, mount.ID, "nodes", "node", nodeIDs, mount.Relationships.Nodes.ids()) &&
+		r.reconcileRelation(ctx, diags, mount.ID, "eggs", "egg", eggIDs, mount.Relationships.Eggs.ids())
+}
+
+// reconcileRelation reconciles the attachments of one relation of a mount,
+// relation being "nodes" or "eggs". Missing attachments are made in a single
+// request, the panel only detaches one object per request.
+func (r *mountResource) reconcileRelation(ctx context.Context, diags *diag.Diagnostics, mountID int32, relation string, object string, planned []int32, existing []int32) bool {
+	plannedIDs := make(map[int32]bool)
+	for _, id := range planned {
+		plannedIDs[id] = true
+	}
+	existingIDs := make(map[int32]bool)
+	for _, id := range existing {
+		existingIDs[id] = true
+	}
+
+	// Detach unneeded nodes or eggs
+	for _, id := range existing {
+		// Stop early when the operation has been cancelled
+		if err := ctx.Err(); err != nil {
+			diags.AddError(
+				"Error detaching mount",
+				contextErrorDetail(ctx, "detaching the mount from "+object+" "+strconv.Itoa(int(id)), "Could not detach mount: "+err.Error()),
+			)
+			return false
+		}
+
+		if !plannedIDs[id] {
+			err := detachMount(ctx, r.client, mountID, relation, id)
+			if err != nil && !isNotFound(err) {
+				diags.AddError(
+					"Error detaching mount",
+					contextErrorDetail(ctx, "detaching the mount from "+object+" "+strconv.Itoa(int(id)), "Could not detach mount, unexpected error: "+err.Error()),
+				)
+				return false
+			}
+		}
+	}
+
+	// Attach missing nodes or eggs
+	missing := []int32{}
+	for _, id := range planned {
+		if !existingIDs[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return true
+	}
+
+	err := attachMount(ctx, r.client, mountID, relation, missing)
+	if err != nil {
+		addAPIError(diags, mountAPIFields, err,
+			"Error attaching mount",
+			contextErrorDetail(ctx, "attaching the mount to the "+relation, "Could not attach mount, unexpected error: "+err.Error()),
+		)
+		return false
+	}
+
+	return true
+}
+
+func (m *mountResourceModel) fromAPI(mount apiMount) {
+	m.ID = types.Int32Value(mount.ID)
+	m.UUID = types.StringValue(mount.UUID)
+	m.Name = types.StringValue(mount.Name)
+	m.Description = types.StringValue(mount.Description)
+	m.Source = types.StringValue(mount.Source)
+	m.Target = types.StringValue(mount.Target)
+	m.ReadOnly = types.BoolValue(mount.ReadOnly)
+	m.UserMountable = types.BoolValue(mount.UserMountable)
+	m.NodeIDs = int32sToValues(mount.Relationships.Nodes.ids())
+	m.EggIDs = int32sToValues(mount.Relationships.Eggs.ids())
+}
+
+func (m mountResourceModel) toAPI() apiPartialMount {
+	return apiPartialMount{
+		Name:          m.Name.ValueString(),
+		Description:   m.Description.ValueString(),
+		Source:        m.Source.ValueString(),
+		Target:        m.Target.ValueString(),
+		ReadOnly:      m.ReadOnly.ValueBool(),
+		UserMountable: m.UserMountable.ValueBool(),
+	}
+}
+
+// int32Values returns the values of a list of Terraform integers.
+func int32Values(values []types.Int32) []int32 {
+	ints := make([]int32, len(values))
+	for i, value := range values {
+		ints[i] = value.ValueInt32()
+	}
+	return ints
+}
+
+// int32sToValues returns a list of Terraform integers, never nil so empty sets
+// stay distinguishable from unset ones.
+func int32sToValues(ints []int32) []types.Int32 {
+	values := make([]types.Int32, len(ints))
+	for i, value := range ints {
+		values[i] = types.Int32Value(value)
+	}
+	return values
+}
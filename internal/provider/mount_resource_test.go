@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMountResourceRead(t *testing.T) {
+	state := replayResourceRead(t, NewMountResource(), "mount_resource_read", map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.Number, 1),
+		"name": tftypes.NewValue(tftypes.String, "modpacks"),
+	})
+
+	var mount mountResourceModel
+	replayStateGet(t, state, &mount)
+
+	replayCheck(t, "id", mount.ID, types.Int32Value(1))
+	replayCheck(t, "uuid", mount.UUID, types.StringValue("5a3e6b4c-2f0d-4d8e-9b1a-7c6f5e4d3c2b"))
+	replayCheck(t, "name", mount.Name, types.StringValue("modpacks"))
+	replayCheck(t, "description", mount.Description, types.StringValue("Shared modpack archives"))
+	replayCheck(t, "source", mount.Source, types.StringValue("/srv/modpacks"))
+	replayCheck(t, "target", mount.Target, types.StringValue("/mnt/modpacks"))
+	replayCheck(t, "read_only", mount.ReadOnly, types.BoolValue(true))
+	replayCheck(t, "user_mountable", mount.UserMountable, types.BoolValue(false))
+
+	if len(mount.NodeIDs) != 2 || mount.NodeIDs[0].ValueInt32() != 2 || mount.NodeIDs[1].ValueInt32() != 5 {
+		t.Errorf("node_ids is %v, expected [2 5]", mount.NodeIDs)
+	}
+	if len(mount.EggIDs) != 1 || mount.EggIDs[0].ValueInt32() != 3 {
+		t.Errorf("egg_ids is %v, expected [3]", mount.EggIDs)
+	}
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nestDataSource{}
+	_ datasource.DataSourceWithConfigure = &nestDataSource{}
+)
+
+// nestDataSourceModel maps the data source schema data.
+type nestDataSourceModel struct {
+	ID          types.Int32  `tfsdk:"id"`
+	UUID        types.String `tfsdk:"uuid"`
+	Author      types.String `tfsdk:"author"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// NewNestDataSource is a helper function to simplify the provider implementation.
+func NewNestDataSource() datasource.DataSource {
+	return &nestDataSource{}
+}
+
+// nestDataSource is the data source implementation.
+type nestDataSource struct {
+	client *pterodactyl.Client
+}
+
+// Metadata returns the data source type name.
+func (d *nestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nest"
+}
+
+// Schema defines the schema for the data source.
+func (d *nestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl nest data source allows Terraform to read a nests data from the Pterodactyl Panel API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				Description: "The ID of the nest.",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("uuid"),
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "The UUID of the nest.",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("uuid"),
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"author": schema.StringAttribute{
+				Description: "The author of the nest.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the nest.",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("uuid"),
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the nest.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The creation date of the nest.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The last update date of the nest.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *nestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nestDataSourceModel
+
+	// Get the attributes from the request
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the nest from the API based on the provided attribute
+	var nest apiNest
+	if !state.ID.IsNull() {
+		var err error
+		nest, err = getNest(ctx, d.client, state.ID.ValueInt32())
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Pterodactyl Nest",
+				err.Error(),
+			)
+			return
+		}
+	} else if !state.UUID.IsNull() || !state.Name.IsNull() {
+		nests, err := getNests(ctx, d.client)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Pterodactyl Nest",
+				err.Error(),
+			)
+			return
+		}
+
+		found := false
+		for _, n := range nests {
+			if (!state.UUID.IsNull() && n.UUID == state.UUID.ValueString()) ||
+				(!state.Name.IsNull() && n.Name == state.Name.ValueString()) {
+				nest = n
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			resp.Diagnostics.AddError(
+				"Unable to Read Pterodactyl Nest",
+				"No nest with the given uuid or name exists in the Pterodactyl Panel.",
+			)
+			return
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing Attribute",
+			"One of 'id', 'uuid' or 'name' must be specified.",
+		)
+		return
+	}
+
+	// Map response body to model
+	state = nestDataSourceModel{
+		ID:          types.Int32Value(nest.ID),
+		UUID:        types.StringValue(nest.UUID),
+		Author:      types.StringValue(nest.Author),
+		Name:        types.StringValue(nest.Name),
+		Description: types.StringValue(nest.Description),
+		CreatedAt:   types.StringValue(nest.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:   types.StringValue(nest.UpdatedAt.Format(time.RFC3339)),
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Application
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNestDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewNestDataSource(), "nest_data_source_read", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "Rust"),
+	})
+
+	var nest nestDataSourceModel
+	replayStateGet(t, state, &nest)
+
+	replayCheck(t, "id", nest.ID, types.Int32Value(4))
+	replayCheck(t, "uuid", nest.UUID, types.StringValue("e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a5b"))
+	replayCheck(t, "description", nest.Description, types.StringValue("Rust - A game where you must fight to survive."))
+	replayCheck(t, "created_at", nest.CreatedAt, types.StringValue("2023-11-30T21:00:01Z"))
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nestsDataSource{}
+	_ datasource.DataSourceWithConfigure = &nestsDataSource{}
+)
+
+// NewNestsDataSource is a helper function to simplify the provider implementation.
+func NewNestsDataSource() datasource.DataSource {
+	return &nestsDataSource{}
+}
+
+// nestsDataSource is the data source implementation.
+type nestsDataSource struct {
+	client *pterodactyl.Client
+}
+
+// nestsDataSourceModel maps the data source schema data.
+type nestsDataSourceModel struct {
+	Nests []Nest `tfsdk:"nests"`
+}
+
+// Nest schema data.
+type Nest struct {
+	ID          types.Int32  `tfsdk:"id"`
+	UUID        types.String `tfsdk:"uuid"`
+	Author      types.String `tfsdk:"author"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *nestsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nests"
+}
+
+// Schema defines the schema for the data source.
+func (d *nestsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl nests data source allows Terraform to read nests from the Pterodactyl API.",
+		Attributes: map[string]schema.Attribute{
+			"nests": schema.ListNestedAttribute{
+				Description: "The list of nests.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Description: "The ID of the nest.",
+							Computed:    true,
+						},
+						"uuid": schema.StringAttribute{
+							Description: "The UUID of the nest.",
+							Computed:    true,
+						},
+						"author": schema.StringAttribute{
+							Description: "The author of the nest.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the nest.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the nest.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The creation date of the nest.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The last update date of the nest.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *nestsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nestsDataSourceModel
+
+	nests, err := getNests(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Pterodactyl Nests",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Nests = make([]Nest, 0, len(nests))
+	for _, nest := range nests {
+		state.Nests = append(state.Nests, Nest{
+			ID:          types.Int32Value(nest.ID),
+			UUID:        types.StringValue(nest.UUID),
+			Author:      types.StringValue(nest.Author),
+			Name:        types.StringValue(nest.Name),
+			Description: types.StringValue(nest.Description),
+			CreatedAt:   types.StringValue(nest.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:   types.StringValue(nest.UpdatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nestsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Application
+}
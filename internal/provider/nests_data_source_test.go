@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNestsDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewNestsDataSource(), "nests_data_source_read", nil)
+
+	var nests nestsDataSourceModel
+	replayStateGet(t, state, &nests)
+
+	if len(nests.Nests) != 3 {
+		t.Fatalf("expected the 3 nests of both pages, got %d", len(nests.Nests))
+	}
+
+	replayCheck(t, "nests.0.name", nests.Nests[0].Name, types.StringValue("Minecraft"))
+	replayCheck(t, "nests.0.author", nests.Nests[0].Author, types.StringValue("support@pterodactyl.io"))
+	replayCheck(t, "nests.1.id", nests.Nests[1].ID, types.Int32Value(4))
+	replayCheck(t, "nests.1.updated_at", nests.Nests[1].UpdatedAt, types.StringValue("2024-02-14T10:20:30Z"))
+	replayCheck(t, "nests.2.name", nests.Nests[2].Name, types.StringValue("Custom"))
+	replayCheck(t, "nests.2.description", nests.Nests[2].Description, types.StringValue(""))
+}
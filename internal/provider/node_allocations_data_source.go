@@ -103,7 +103,9 @@ func (d *nodeAllocationsDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	nodes, err := d.client.GetNodeAllocations(state.NodeID)
+	client := withContext(ctx, d.client)
+
+	nodes, err := client.GetNodeAllocations(state.NodeID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Pterodactyl Nodes",
@@ -142,15 +144,15 @@ func (d *nodeAllocationsDataSource) Configure(ctx context.Context, req datasourc
 		return
 	}
 
-	client, ok := req.ProviderData.(*pterodactyl.Client)
+	clients, ok := req.ProviderData.(*pterodactylClients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *pterodactyl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = clients.Application
 }
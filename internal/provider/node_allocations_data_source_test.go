@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNodeAllocationsDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewNodeAllocationsDataSource(), "node_allocations_data_source_read", map[string]tftypes.Value{
+		"nodeid": tftypes.NewValue(tftypes.Number, 2),
+	})
+
+	var allocations nodeAllocationsDataSourceModel
+	replayStateGet(t, state, &allocations)
+
+	if len(allocations.NodeAllocations) != 2 {
+		t.Fatalf("expected 2 allocations, got %d", len(allocations.NodeAllocations))
+	}
+
+	replayCheck(t, "allocations.0.id", allocations.NodeAllocations[0].ID, types.Int32Value(11))
+	replayCheck(t, "allocations.0.ip", allocations.NodeAllocations[0].IP, types.StringValue("10.0.0.1"))
+	replayCheck(t, "allocations.0.port", allocations.NodeAllocations[0].Port, types.Int32Value(25565))
+	replayCheck(t, "allocations.0.notes", allocations.NodeAllocations[0].Notes, types.StringValue(""))
+	replayCheck(t, "allocations.0.assigned", allocations.NodeAllocations[0].Assigned, types.BoolValue(true))
+	replayCheck(t, "allocations.1.id", allocations.NodeAllocations[1].ID, types.Int32Value(12))
+	replayCheck(t, "allocations.1.alias", allocations.NodeAllocations[1].Alias, types.StringValue("play.example.com"))
+	replayCheck(t, "allocations.1.assigned", allocations.NodeAllocations[1].Assigned, types.BoolValue(false))
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeConfigurationDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeConfigurationDataSource{}
+)
+
+// nodeConfigurationDataSourceModel maps the data source schema data.
+type nodeConfigurationDataSourceModel struct {
+	NodeID        types.Int32              `tfsdk:"node_id"`
+	Debug         types.Bool               `tfsdk:"debug"`
+	UUID          types.String             `tfsdk:"uuid"`
+	TokenID       types.String             `tfsdk:"token_id"`
+	Token         types.String             `tfsdk:"token"`
+	API           *NodeConfigurationAPI    `tfsdk:"api"`
+	System        *NodeConfigurationSystem `tfsdk:"system"`
+	AllowedMounts []types.String           `tfsdk:"allowed_mounts"`
+	Remote        types.String             `tfsdk:"remote"`
+	ConfigYAML    types.String             `tfsdk:"config_yaml"`
+}
+
+// NodeConfigurationAPI schema data.
+type NodeConfigurationAPI struct {
+	Host        types.String         `tfsdk:"host"`
+	Port        types.Int32          `tfsdk:"port"`
+	SSL         NodeConfigurationSSL `tfsdk:"ssl"`
+	UploadLimit types.Int32          `tfsdk:"upload_limit"`
+}
+
+// NodeConfigurationSSL schema data.
+type NodeConfigurationSSL struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Cert    types.String `tfsdk:"cert"`
+	Key     types.String `tfsdk:"key"`
+}
+
+// NodeConfigurationSystem schema data.
+type NodeConfigurationSystem struct {
+	Data types.String          `tfsdk:"data"`
+	SFTP NodeConfigurationSFTP `tfsdk:"sftp"`
+}
+
+// NodeConfigurationSFTP schema data.
+type NodeConfigurationSFTP struct {
+	BindPort types.Int32 `tfsdk:"bind_port"`
+}
+
+// NewNodeConfigurationDataSource is a helper function to simplify the provider implementation.
+func NewNodeConfigurationDataSource() datasource.DataSource {
+	return &nodeConfigurationDataSource{}
+}
+
+// nodeConfigurationDataSource is the data source implementation.
+type nodeConfigurationDataSource struct {
+	client *pterodactyl.Client
+}
+
+// Metadata returns the data source type name.
+func (d *nodeConfigurationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_configuration"
+}
+
+// Schema defines the schema for the data source.
+func (d *nodeConfigurationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl node configuration data source allows Terraform to read the Wings configuration of a node from the Pterodactyl Panel API, " +
+			"e.g. to provision the node with its config.yml.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.Int32Attribute{
+				Description: "The ID of the node.",
+				Required:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"debug": schema.BoolAttribute{
+				Description: "Is Wings running in debug mode?",
+				Computed:    true,
+			},
+			"uuid": schema.StringAttribute{
+				Description: "The UUID of the node.",
+				Computed:    true,
+			},
+			"token_id": schema.StringAttribute{
+				Description: "The ID of the token Wings authenticates to the panel with.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The token Wings authenticates to the panel with.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"api": schema.SingleNestedAttribute{
+				Description: "The settings of the API of Wings.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						Description: "The address the API listens on.",
+						Computed:    true,
+					},
+					"port": schema.Int32Attribute{
+						Description: "The port the API listens on.",
+						Computed:    true,
+					},
+					"ssl": schema.SingleNestedAttribute{
+						Description: "The SSL settings of the API.",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								Description: "Is SSL enabled?",
+								Computed:    true,
+							},
+							"cert": schema.StringAttribute{
+								Description: "The path of the certificate.",
+								Computed:    true,
+							},
+							"key": schema.StringAttribute{
+								Description: "The path of the private key.",
+								Computed:    true,
+							},
+						},
+					},
+					"upload_limit": schema.Int32Attribute{
+						Description: "The maximum size of uploads in MiB.",
+						Computed:    true,
+					},
+				},
+			},
+			"system": schema.SingleNestedAttribute{
+				Description: "The system settings of Wings.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"data": schema.StringAttribute{
+						Description: "The directory the data of the servers is stored in.",
+						Computed:    true,
+					},
+					"sftp": schema.SingleNestedAttribute{
+						Description: "The settings of the SFTP server of Wings.",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"bind_port": schema.Int32Attribute{
+								Description: "The port the SFTP server listens on.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+			"allowed_mounts": schema.ListAttribute{
+				Description: "The directories servers are allowed to mount.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"remote": schema.StringAttribute{
+				Description: "The URL of the panel.",
+				Computed:    true,
+			},
+			"config_yaml": schema.StringAttribute{
+				Description: "The configuration rendered as the config.yml of Wings, including the token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *nodeConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nodeConfigurationDataSourceModel
+
+	// Get the attributes from the request
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := getNodeConfiguration(ctx, d.client, state.NodeID.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Pterodactyl Node Configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Debug = types.BoolValue(configuration.Debug)
+	state.UUID = types.StringValue(configuration.UUID)
+	state.TokenID = types.StringValue(configuration.TokenID)
+	state.Token = types.StringValue(configuration.Token)
+	state.API = &NodeConfigurationAPI{
+		Host: types.StringValue(configuration.API.Host),
+		Port: types.Int32Value(configuration.API.Port),
+		SSL: NodeConfigurationSSL{
+			Enabled: types.BoolValue(configuration.API.SSL.Enabled),
+			Cert:    types.StringValue(configuration.API.SSL.Cert),
+			Key:     types.StringValue(configuration.API.SSL.Key),
+		},
+		UploadLimit: types.Int32Value(configuration.API.UploadLimit),
+	}
+	state.System = &NodeConfigurationSystem{
+		Data: types.StringValue(configuration.System.Data),
+		SFTP: NodeConfigurationSFTP{
+			BindPort: types.Int32Value(configuration.System.SFTP.BindPort),
+		},
+	}
+	state.AllowedMounts = make([]types.String, len(configuration.AllowedMounts))
+	for i, mount := range configuration.AllowedMounts {
+		state.AllowedMounts[i] = types.StringValue(mount)
+	}
+	state.Remote = types.StringValue(configuration.Remote)
+	state.ConfigYAML = types.StringValue(configuration.YAML)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nodeConfigurationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Application
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNodeConfigurationDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewNodeConfigurationDataSource(), "node_configuration_data_source_read", map[string]tftypes.Value{
+		"node_id": tftypes.NewValue(tftypes.Number, 2),
+	})
+
+	var configuration nodeConfigurationDataSourceModel
+	replayStateGet(t, state, &configuration)
+
+	replayCheck(t, "uuid", configuration.UUID, types.StringValue("9f0e3c1a-6b2d-4c8e-a1f0-2d3e4f5a6b7c"))
+	replayCheck(t, "token_id", configuration.TokenID, types.StringValue("0123456789012345"))
+	replayCheck(t, "api.port", configuration.API.Port, types.Int32Value(8080))
+	replayCheck(t, "api.ssl.enabled", configuration.API.SSL.Enabled, types.BoolValue(true))
+	replayCheck(t, "system.sftp.bind_port", configuration.System.SFTP.BindPort, types.Int32Value(2022))
+	if len(configuration.AllowedMounts) != 1 {
+		t.Fatalf("allowed_mounts has %d elements, expected 1", len(configuration.AllowedMounts))
+	}
+	replayCheck(t, "allowed_mounts.0", configuration.AllowedMounts[0], types.StringValue("/mnt/shared"))
+	replayCheck(t, "remote", configuration.Remote, types.StringValue("https://panel.example.com"))
+
+	// Strings which would read as numbers stay quoted, the keys keep the
+	// order of the panel.
+	replayCheck(t, "config_yaml", configuration.ConfigYAML, types.StringValue(`debug: false
+uuid: 9f0e3c1a-6b2d-4c8e-a1f0-2d3e4f5a6b7c
+token_id: "0123456789012345"
+token: Zk8fJ2nQx7Lr4Tb9Wc1Vd6Hs3Ym5Pa0Ge8Ku2Ni7Oj4Rq9Sw1Xz6Bt3Cv5Dl0Ef
+api:
+  host: 0.0.0.0
+  port: 8080
+  ssl:
+    enabled: true
+    cert: /etc/letsencrypt/live/fra-1.example.com/fullchain.pem
+    key: /etc/letsencrypt/live/fra-1.example.com/privkey.pem
+  upload_limit: 100
+system:
+  data: /var/lib/pterodactyl/volumes
+  sftp:
+    bind_port: 2022
+allowed_mounts:
+  - /mnt/shared
+remote: https://panel.example.com
+`))
+}
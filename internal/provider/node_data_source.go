@@ -184,11 +184,13 @@ func (d *nodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	client := withContext(ctx, d.client)
+
 	// Fetch the node from the API based on the provided attribute
 	var node pterodactyl.Node
 	if !state.ID.IsNull() {
 		var err error
-		node, err = d.client.GetNode(state.ID.ValueInt32())
+		node, err = client.GetNode(state.ID.ValueInt32())
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -199,7 +201,7 @@ func (d *nodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 	} else if !state.UUID.IsNull() {
 		uuid := state.UUID.ValueString()
-		nodes, err := d.client.GetNodes()
+		nodes, err := client.GetNodes()
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -217,7 +219,7 @@ func (d *nodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 	} else if !state.Name.IsNull() {
 		name := state.Name.ValueString()
-		nodes, err := d.client.GetNodes()
+		nodes, err := client.GetNodes()
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -281,15 +283,15 @@ func (d *nodeDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*pterodactyl.Client)
+	clients, ok := req.ProviderData.(*pterodactylClients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *pterodactyl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = clients.Application
 }
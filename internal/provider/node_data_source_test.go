@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNodeDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewNodeDataSource(), "node_data_source_read", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "nyc-1"),
+	})
+
+	var node nodeDataSourceModel
+	replayStateGet(t, state, &node)
+
+	replayCheck(t, "id", node.ID, types.Int32Value(5))
+	replayCheck(t, "uuid", node.UUID, types.StringValue("1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"))
+	replayCheck(t, "public", node.Public, types.BoolValue(false))
+	replayCheck(t, "description", node.Description, types.StringValue(""))
+	replayCheck(t, "location_id", node.LocationID, types.Int32Value(4))
+	replayCheck(t, "behind_proxy", node.BehindProxy, types.BoolValue(true))
+	replayCheck(t, "maintenance_mode", node.MaintenanceMode, types.BoolValue(true))
+	replayCheck(t, "memory_overallocate", node.MemoryOverallocate, types.Int32Value(10))
+	replayCheck(t, "upload_size", node.UploadSize, types.Int32Value(50))
+	replayCheck(t, "daemon_base", node.DaemonBase, types.StringValue("/srv/daemon-data"))
+	replayCheck(t, "created_at", node.CreatedAt, types.StringValue("2024-02-21T08:00:00Z"))
+}
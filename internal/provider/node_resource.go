@@ -3,23 +3,33 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &nodeResource{}
-	_ resource.ResourceWithConfigure = &nodeResource{}
-	// _ resource.ResourceWithImportState = &nodeResource{}
+	_ resource.Resource                = &nodeResource{}
+	_ resource.ResourceWithConfigure   = &nodeResource{}
+	_ resource.ResourceWithModifyPlan  = &nodeResource{}
+	_ resource.ResourceWithImportState = &nodeResource{}
 )
 
 // NewNodeResource is a helper function to simplify the provider implementation.
@@ -29,32 +39,35 @@ func NewNodeResource() resource.Resource {
 
 // nodeResource is the resource implementation.
 type nodeResource struct {
-	client *pterodactyl.Client
+	client  *pterodactyl.Client
+	clients *pterodactylClients
 }
 
 // nodeResourceModel maps the resource schema data.
 type nodeResourceModel struct {
-	ID                 types.Int32  `tfsdk:"id"`
-	UUID               types.String `tfsdk:"uuid"`
-	Name               types.String `tfsdk:"name"`
-	Description        types.String `tfsdk:"description"`
-	Public             types.Bool   `tfsdk:"public"`
-	BehindProxy        types.Bool   `tfsdk:"behind_proxy"`
-	MaintenanceMode    types.Bool   `tfsdk:"maintenance_mode"`
-	LocationID         types.Int32  `tfsdk:"location_id"`
-	FQDN               types.String `tfsdk:"fqdn"`
-	Scheme             types.String `tfsdk:"scheme"`
-	Memory             types.Int32  `tfsdk:"memory"`
-	MemoryOverallocate types.Int32  `tfsdk:"memory_overallocate"`
-	Disk               types.Int32  `tfsdk:"disk"`
-	DiskOverallocate   types.Int32  `tfsdk:"disk_overallocate"`
-	UploadSize         types.Int32  `tfsdk:"upload_size"`
-	DaemonSFTP         types.Int32  `tfsdk:"daemon_sftp"`
-	DaemonListen       types.Int32  `tfsdk:"daemon_listen"`
-	DaemonBase         types.String `tfsdk:"daemon_base"`
-	CreatedAt          types.String `tfsdk:"created_at"`
-	UpdatedAt          types.String `tfsdk:"updated_at"`
-	Allocations        []Allocation `tfsdk:"allocations"`
+	ID                  types.Int32    `tfsdk:"id"`
+	UUID                types.String   `tfsdk:"uuid"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	Public              types.Bool     `tfsdk:"public"`
+	BehindProxy         types.Bool     `tfsdk:"behind_proxy"`
+	MaintenanceMode     types.Bool     `tfsdk:"maintenance_mode"`
+	LocationID          types.Int32    `tfsdk:"location_id"`
+	FQDN                types.String   `tfsdk:"fqdn"`
+	Scheme              types.String   `tfsdk:"scheme"`
+	Memory              types.Int32    `tfsdk:"memory"`
+	MemoryOverallocate  types.Int32    `tfsdk:"memory_overallocate"`
+	Disk                types.Int32    `tfsdk:"disk"`
+	DiskOverallocate    types.Int32    `tfsdk:"disk_overallocate"`
+	UploadSize          types.Int32    `tfsdk:"upload_size"`
+	DaemonSFTP          types.Int32    `tfsdk:"daemon_sftp"`
+	DaemonListen        types.Int32    `tfsdk:"daemon_listen"`
+	DaemonBase          types.String   `tfsdk:"daemon_base"`
+	CreatedAt           types.String   `tfsdk:"created_at"`
+	UpdatedAt           types.String   `tfsdk:"updated_at"`
+	Allocations         []Allocation   `tfsdk:"allocations"`
+	ForceDeleteAssigned types.Bool     `tfsdk:"force_delete_assigned"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type PartialAllocation struct {
@@ -62,13 +75,41 @@ type PartialAllocation struct {
 	Port types.Int32  `tfsdk:"port"`
 }
 
+// nodeAPIFields maps the fields of panel validation errors to the node schema.
+var nodeAPIFields = rootAPIFields(
+	"name", "description", "public", "behind_proxy", "maintenance_mode", "location_id", "fqdn", "scheme",
+	"memory", "memory_overallocate", "disk", "disk_overallocate", "upload_size", "daemon_sftp", "daemon_listen",
+)
+
+// nodeAllocationAPIFields maps the fields of panel validation errors of
+// allocations to the allocations of the node schema. Elements of a set can
+// only be addressed by their whole value, so errors are reported on the set.
+var nodeAllocationAPIFields = apiFieldPaths{
+	"ip":    path.Root("allocations"),
+	"alias": path.Root("allocations"),
+	"ports": path.Root("allocations"),
+}
+
+// allocationKey identifies an allocation of a node by its IP and port.
+func allocationKey(ip string, port int32) string {
+	return net.JoinHostPort(ip, strconv.Itoa(int(port)))
+}
+
+// nodeImportIDs are the import IDs of nodes.
+var nodeImportIDs = importIDLookup{
+	Object:     "node",
+	Endpoint:   "/api/application/nodes",
+	DefaultKey: "id",
+	Keys:       []string{"uuid", "name"},
+}
+
 // Metadata returns the resource type name.
 func (r *nodeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_node"
 }
 
 // Schema defines the schema for the resource.
-func (r *nodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *nodeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The Pterodactyl node resource allows Terraform to manage nodes in the Pterodactyl Panel API.",
 		Attributes: map[string]schema.Attribute{
@@ -146,13 +187,14 @@ func (r *nodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "The daemon listen of the node.",
 				Required:    true,
 			},
-			"allocations": schema.ListNestedAttribute{
-				Description: "The list of allocations to a node.",
-				Required:    true,
+			"allocations": schema.SetNestedAttribute{
+				Description: "The set of allocations to a node, identified by their IP and port. " +
+					"Left unmanaged when not set, e.g. to manage the allocations with pterodactyl_allocation instead.",
+				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.Int32Attribute{
-							Description: "The ID of the node.",
+							Description: "The ID of the allocation.",
 							Computed:    true,
 						},
 						"ip": schema.StringAttribute{
@@ -160,16 +202,24 @@ func (r *nodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 							Required:    true,
 						},
 						"alias": schema.StringAttribute{
-							Description: "A alias for the allocation",
+							Description: "The alias shown to users instead of the IP, e.g. a public IP or host name. The panel cannot change the alias of an allocation, so changing it recreates the allocation, which is refused while it is assigned to a server, even with force_delete_assigned. Left unmanaged when not set.",
+							Optional:    true,
 							Computed:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 191),
+							},
 						},
 						"port": schema.Int32Attribute{
 							Description: "The port allocated in the allocation",
 							Required:    true,
 						},
 						"notes": schema.StringAttribute{
-							Description: "Any notes to the allocation",
+							Description: "Any notes to the allocation. The panel only allows changing them through the client API once the allocation is assigned to a server, which requires the client_api_key of the provider, so plans setting the notes of new or unassigned allocations are refused. Left unmanaged when not set.",
+							Optional:    true,
 							Computed:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 256),
+							},
 						},
 						"assigned": schema.BoolAttribute{
 							Description: "Is the allocation assigned?",
@@ -178,6 +228,13 @@ func (r *nodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					},
 				},
 			},
+			"force_delete_assigned": schema.BoolAttribute{
+				Description: "Delete allocations assigned to a server when they are removed from the allocations, after removing them from the server. " +
+					"Without it, such plans are refused. The default allocation of a server is never removed.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"daemon_base": schema.StringAttribute{
 				Description: "The base file for the daemon of the node.",
 				Computed:    true,
@@ -194,6 +251,106 @@ func (r *nodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan refuses plans deleting allocations assigned to a server, unless
+// force_delete_assigned is set, and plans changing the alias of assigned
+// allocations. Unmanaged allocations are never deleted. Notes of allocations
+// not assigned to a server, including new ones, are refused as well, as the
+// panel only allows changing the notes of assigned allocations.
+func (r *nodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destruction deletes the whole node
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var allocations types.Set
+	var force types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allocations"), &allocations)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("force_delete_assigned"), &force)...)
+	if resp.Diagnostics.HasError() || allocations.IsNull() || allocations.IsUnknown() {
+		return
+	}
+
+	// Every allocation is new on creation
+	var state nodeResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	var planned []Allocation
+	resp.Diagnostics.Append(allocations.ElementsAs(ctx, &planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plannedAllocations := make(map[string]Allocation, len(planned))
+	for _, allocation := range planned {
+		// Allocations with unknown addresses cannot be matched yet
+		if allocation.IP.IsUnknown() || allocation.Port.IsUnknown() {
+			return
+		}
+		plannedAllocations[allocationKey(allocation.IP.ValueString(), allocation.Port.ValueInt32())] = allocation
+	}
+
+	existing := make(map[string]Allocation, len(state.Allocations))
+	for _, allocation := range state.Allocations {
+		existing[allocationKey(allocation.IP.ValueString(), allocation.Port.ValueInt32())] = allocation
+	}
+
+	for _, plannedAllocation := range planned {
+		key := allocationKey(plannedAllocation.IP.ValueString(), plannedAllocation.Port.ValueInt32())
+		allocation, ok := existing[key]
+		if ok && (allocation.Assigned.ValueBool() || !allocationFieldChanged(plannedAllocation.Notes, allocation.Notes.ValueStringPointer())) {
+			continue
+		}
+		if !ok && (plannedAllocation.Notes.IsNull() || plannedAllocation.Notes.IsUnknown()) {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allocations"),
+			"Unassigned Node Allocation Notes",
+			"Allocation "+key+" is not assigned to a server and its notes cannot be set: the panel only allows changing them through the client API "+
+				"once the allocation is assigned to a server. Remove the notes until the allocation is assigned.",
+		)
+	}
+
+	forced := force.IsUnknown() || force.ValueBool()
+	for _, allocation := range state.Allocations {
+		if !allocation.Assigned.ValueBool() {
+			continue
+		}
+
+		key := allocationKey(allocation.IP.ValueString(), allocation.Port.ValueInt32())
+		plannedAllocation, ok := plannedAllocations[key]
+		switch {
+		case !ok && !forced:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("allocations"),
+				"Assigned Node Allocation Removed",
+				"Allocation "+key+" is assigned to a server and would be deleted. "+
+					"Keep it in the allocations or set force_delete_assigned to remove it from the server and delete it.",
+			)
+		// The alias can only be changed by recreating the allocation, which
+		// would take it from its server
+		case ok && allocationFieldChanged(plannedAllocation.Alias, allocation.Alias.ValueStringPointer()):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("allocations"),
+				"Assigned Node Allocation Alias Changed",
+				"Allocation "+key+" is assigned to a server and its alias cannot be changed: the panel has no endpoint to change the alias of an allocation "+
+					"and the allocation cannot be recreated while it is assigned to a server. Remove it from the server first.",
+			)
+		}
 	}
 }
 
@@ -207,6 +364,15 @@ func (r *nodeResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create partial node
 	partialNode := pterodactyl.PartialNode{
 		Name:               plan.Name.ValueString(),
@@ -226,39 +392,14 @@ func (r *nodeResource) Create(ctx context.Context, req resource.CreateRequest, r
 		DaemonSFTP:         plan.DaemonSFTP.ValueInt32(),
 	}
 
+	client := withContext(ctx, r.client)
+
 	// Create new node
-	node, err := r.client.CreateNode(partialNode)
+	node, err := client.CreateNode(partialNode)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics, nodeAPIFields, err,
 			"Error creating node",
-			"Could not create node, unexpected error: "+err.Error(),
-		)
-		return
-	}
-
-	for _, allocation := range plan.Allocations {
-		// Create partial allocation
-		partialAllocation := pterodactyl.PartialAllocation{
-			IP:    allocation.IP.ValueString(),
-			Ports: []string{strconv.Itoa(int(allocation.Port.ValueInt32()))},
-		}
-
-		// Create new allocation
-		err := r.client.CreateAllocation(node.ID, partialAllocation)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error creating node allocation",
-				"Could not create node allocation, unexpected error: "+err.Error(),
-			)
-			return
-		}
-	}
-
-	nodeAllocations, err := r.client.GetNodeAllocations(node.ID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating node allocation",
-			"Could not fetch node allocation, unexpected error: "+err.Error(),
+			contextErrorDetail(ctx, "creating the node", "Could not create node, unexpected error: "+err.Error()),
 		)
 		return
 	}
@@ -285,17 +426,37 @@ func (r *nodeResource) Create(ctx context.Context, req resource.CreateRequest, r
 	plan.CreatedAt = types.StringValue(node.CreatedAt.Format(time.RFC3339))
 	plan.UpdatedAt = types.StringValue(node.UpdatedAt.Format(time.RFC3339))
 
-	plan.Allocations = make([]Allocation, len(nodeAllocations))
+	// Save the node before creating its allocations, so a failure does not
+	// leave an untracked node behind. The allocations are read back once
+	// they exist.
+	allocations := plan.Allocations
+	if allocations != nil {
+		plan.Allocations = []Allocation{}
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	for i, allocation := range nodeAllocations {
-		plan.Allocations[i] = Allocation{
-			ID:       types.Int32Value(allocation.ID),
-			IP:       types.StringValue(allocation.IP),
-			Alias:    types.StringValue(allocation.Alias),
-			Port:     types.Int32Value(allocation.Port),
-			Notes:    types.StringValue(allocation.Notes),
-			Assigned: types.BoolValue(allocation.Assigned),
+	// Create new allocations, unless they are left unmanaged. Notes of new
+	// allocations are refused by ModifyPlan, as they are not assigned yet.
+	if allocations != nil {
+		r.createAllocations(ctx, &resp.Diagnostics, node.ID, allocations)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+
+		nodeAllocations, err := getNodeAllocations(ctx, r.client, node.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating node allocation",
+				contextErrorDetail(ctx, "reading the node allocations", "Could not fetch node allocation, unexpected error: "+err.Error()),
+			)
+			return
+		}
+
+		plan.Allocations = nodeAllocationsFromAPI(nodeAllocations)
 	}
 
 	// Set state to fully populated data
@@ -316,21 +477,30 @@ func (r *nodeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Get refreshed node value from Pterodactyl
-	node, err := r.client.GetNode(state.ID.ValueInt32())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Pterodactyl Node",
-			"Could not read Pterodactyl node ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error(),
-		)
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	nodeAllocations, err := r.client.GetNodeAllocations(state.ID.ValueInt32())
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client := withContext(ctx, r.client)
+
+	// Get refreshed node value from Pterodactyl
+	node, err := client.GetNode(state.ID.ValueInt32())
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Pterodactyl node not found, removing it from state", map[string]interface{}{
+			"id": state.ID.ValueInt32(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error Reading Pterodactyl Node Allocations",
-			"Could not read Pterodactyl node allocations for node ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error(),
+			"Error Reading Pterodactyl Node",
+			contextErrorDetail(ctx, "reading the node", "Could not read Pterodactyl node ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error()),
 		)
 		return
 	}
@@ -356,17 +526,23 @@ func (r *nodeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.CreatedAt = types.StringValue(node.CreatedAt.Format(time.RFC3339))
 	state.UpdatedAt = types.StringValue(node.UpdatedAt.Format(time.RFC3339))
 
-	state.Allocations = make([]Allocation, len(nodeAllocations))
-
-	for i, allocation := range nodeAllocations {
-		state.Allocations[i] = Allocation{
-			ID:       types.Int32Value(allocation.ID),
-			IP:       types.StringValue(allocation.IP),
-			Alias:    types.StringValue(allocation.Alias),
-			Port:     types.Int32Value(allocation.Port),
-			Notes:    types.StringValue(allocation.Notes),
-			Assigned: types.BoolValue(allocation.Assigned),
+	// Unmanaged allocations stay out of the state
+	if state.Allocations != nil {
+		nodeAllocations, err := getNodeAllocations(ctx, r.client, state.ID.ValueInt32())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Pterodactyl Node Allocations",
+				contextErrorDetail(ctx, "reading the node allocations", "Could not read Pterodactyl node allocations for node ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error()),
+			)
+			return
 		}
+
+		state.Allocations = nodeAllocationsFromAPI(nodeAllocations)
+	}
+
+	// Not part of the panel data, states of earlier versions lack it
+	if state.ForceDeleteAssigned.IsNull() {
+		state.ForceDeleteAssigned = types.BoolValue(false)
 	}
 
 	// Set refreshed state
@@ -386,6 +562,15 @@ func (r *nodeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Create partial node
 	partialNode := pterodactyl.PartialNode{
 		Name:               plan.Name.ValueString(),
@@ -405,76 +590,26 @@ func (r *nodeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		DaemonListen:       plan.DaemonListen.ValueInt32(),
 	}
 
+	client := withContext(ctx, r.client)
+
 	// Update existing node
-	node, err := r.client.UpdateNode(plan.ID.ValueInt32(), partialNode)
+	node, err := client.UpdateNode(plan.ID.ValueInt32(), partialNode)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics, nodeAPIFields, err,
 			"Error Updating Pterodactyl Node",
-			"Could not update node, unexpected error: "+err.Error(),
+			contextErrorDetail(ctx, "updating the node", "Could not update node, unexpected error: "+err.Error()),
 		)
 		return
 	}
 
-	// Check which allocations need to be created and which need to be deleted
-	nodeAllocations, err := r.client.GetNodeAllocations(plan.ID.ValueInt32())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Pterodactyl Node Allocations",
-			"Could not update node allocations: "+err.Error(),
-		)
-		return
-	}
-
-	// Delete unneeded allocations
-	for _, allocation := range nodeAllocations {
-		found := false
-		for _, planAllocation := range plan.Allocations {
-			if allocation.ID == planAllocation.ID.ValueInt32() {
-				found = true
-				break
-			}
-		}
-
-		if !found {
-			err := r.client.DeleteAllocation(plan.ID.ValueInt32(), allocation.ID)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error deleting node allocation",
-					"Could not delete node allocation, unexpected error: "+err.Error(),
-				)
-				return
-			}
-		}
-	}
-
-	// Create new allocations
-	for _, allocation := range plan.Allocations {
-		if allocation.ID.IsNull() {
-			partialAllocation := pterodactyl.PartialAllocation{
-				IP:    allocation.IP.ValueString(),
-				Ports: []string{strconv.Itoa(int(allocation.Port.ValueInt32()))},
-			}
-			// Create new allocation
-			err := r.client.CreateAllocation(plan.ID.ValueInt32(), partialAllocation)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error creating node allocation",
-					"Could not create node allocation, unexpected error: "+err.Error(),
-				)
-				return
-			}
+	// Unmanaged allocations are left alone
+	if plan.Allocations != nil {
+		plan.Allocations = r.updateAllocations(ctx, &resp.Diagnostics, plan)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	nodeAllocations, err = r.client.GetNodeAllocations(plan.ID.ValueInt32())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Pterodactyl Node Allocations",
-			"Could not update node allocations: "+err.Error(),
-		)
-		return
-	}
-
 	// Update resource plan with updated values
 	plan.Name = types.StringValue(node.Name)
 	plan.UUID = types.StringValue(node.UUID)
@@ -496,19 +631,6 @@ func (r *nodeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	plan.CreatedAt = types.StringValue(node.CreatedAt.Format(time.RFC3339))
 	plan.UpdatedAt = types.StringValue(node.UpdatedAt.Format(time.RFC3339))
 
-	plan.Allocations = make([]Allocation, len(nodeAllocations))
-
-	for i, allocation := range nodeAllocations {
-		plan.Allocations[i] = Allocation{
-			ID:       types.Int32Value(allocation.ID),
-			IP:       types.StringValue(allocation.IP),
-			Alias:    types.StringValue(allocation.Alias),
-			Port:     types.Int32Value(allocation.Port),
-			Notes:    types.StringValue(allocation.Notes),
-			Assigned: types.BoolValue(allocation.Assigned),
-		}
-	}
-
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -526,12 +648,23 @@ func (r *nodeResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client := withContext(ctx, r.client)
+
 	// Delete existing node
-	err := r.client.DeleteNode(state.ID.ValueInt32())
-	if err != nil {
+	err := client.DeleteNode(state.ID.ValueInt32())
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Pterodactyl Node",
-			"Could not delete node, unexpected error: "+err.Error(),
+			contextErrorDetail(ctx, "deleting the node", "Could not delete node, unexpected error: "+err.Error()),
 		)
 		return
 	}
@@ -545,27 +678,33 @@ func (r *nodeResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*pterodactyl.Client)
+	clients, ok := req.ProviderData.(*pterodactylClients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *pterodactyl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = clients.Application
+	r.clients = clients
 }
 
 func (r *nodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, _ := strconv.Atoi(req.ID)
+	nodeID, ok := nodeImportIDs.resolve(ctx, r.client, &resp.Diagnostics, req.ID)
+	if !ok {
+		return
+	}
+
+	client := withContext(ctx, r.client)
 
-	node, err := r.client.GetNode(int32(id))
+	node, err := client.GetNode(nodeID)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error Importing Pterodactyl User",
+			"Error Importing Pterodactyl Node",
 			"Could not import node: "+err.Error(),
 		)
 		return
@@ -595,7 +734,7 @@ func (r *nodeResource) ImportState(ctx context.Context, req resource.ImportState
 		UpdatedAt:          types.StringValue(node.UpdatedAt.Format(time.RFC3339)),
 	}
 
-	nodeAllocations, err := r.client.GetNodeAllocations(state.ID.ValueInt32())
+	nodeAllocations, err := getNodeAllocations(ctx, r.client, state.ID.ValueInt32())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Importing Pterodactyl Node Allocations",
@@ -604,17 +743,16 @@ func (r *nodeResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 
-	state.Allocations = make([]Allocation, len(nodeAllocations))
+	state.Allocations = nodeAllocationsFromAPI(nodeAllocations)
 
-	for i, allocation := range nodeAllocations {
-		state.Allocations[i] = Allocation{
-			ID:       types.Int32Value(allocation.ID),
-			IP:       types.StringValue(allocation.IP),
-			Alias:    types.StringValue(allocation.Alias),
-			Port:     types.Int32Value(allocation.Port),
-			Notes:    types.StringValue(allocation.Notes),
-			Assigned: types.BoolValue(allocation.Assigned),
-		}
+	// Not part of the panel data, states of earlier versions lack it
+	if state.ForceDeleteAssigned.IsNull() {
+		state.ForceDeleteAssigned = types.BoolValue(false)
+	}
+
+	state.Timeouts = importTimeouts(ctx, resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Set state to fully populated data
@@ -624,3 +762,293 @@ func (r *nodeResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 }
+
+// updateAllocations creates and deletes the allocations of the node to match
+// the planned ones and returns the resulting allocations.
+func (r *nodeResource) updateAllocations(ctx context.Context, diags *diag.Diagnostics, plan nodeResourceModel) []Allocation {
+	// Check which allocations need to be created and which need to be deleted
+	nodeAllocations, err := getNodeAllocations(ctx, r.client, plan.ID.ValueInt32())
+	if err != nil {
+		diags.AddError(
+			"Error Updating Pterodactyl Node Allocations",
+			contextErrorDetail(ctx, "reading the node allocations", "Could not update node allocations: "+err.Error()),
+		)
+		return nil
+	}
+
+	// Allocations are matched by IP and port, the IDs of new allocations are
+	// unknown until they have been created.
+	planned := make(map[string]Allocation, len(plan.Allocations))
+	for _, allocation := range plan.Allocations {
+		planned[allocationKey(allocation.IP.ValueString(), allocation.Port.ValueInt32())] = allocation
+	}
+	existing := make(map[string]bool, len(nodeAllocations))
+	for _, allocation := range nodeAllocations {
+		existing[allocationKey(allocation.IP, allocation.Port)] = true
+	}
+
+	// Delete unneeded allocations
+	for _, allocation := range nodeAllocations {
+		// Stop early when the operation has been cancelled
+		if err := ctx.Err(); err != nil {
+			diags.AddError(
+				"Error deleting node allocation",
+				contextErrorDetail(ctx, "deleting allocation "+allocation.IP+":"+strconv.Itoa(int(allocation.Port)), "Could not delete node allocation: "+err.Error()),
+			)
+			return nil
+		}
+
+		key := allocationKey(allocation.IP, allocation.Port)
+		plannedAllocation, ok := planned[key]
+
+		// The panel cannot change the alias of an allocation, recreate it
+		// instead
+		aliasChanged := ok && allocationFieldChanged(plannedAllocation.Alias, allocation.Alias)
+		if ok && !aliasChanged {
+			continue
+		}
+
+		// Assigned allocations are never taken from their server for an alias
+		// change, and only deleted when forced, after removing them from it
+		if allocation.Assigned && aliasChanged {
+			diags.AddAttributeError(
+				path.Root("allocations"),
+				"Error updating node allocation",
+				"Could not change the alias of allocation "+key+": the panel has no endpoint to change the alias of an allocation "+
+					"and the allocation cannot be recreated while it is assigned to a server. Remove it from the server first.",
+			)
+			return nil
+		}
+		if allocation.Assigned && !plan.ForceDeleteAssigned.ValueBool() {
+			diags.AddAttributeError(
+				path.Root("allocations"),
+				"Error deleting node allocation",
+				"Could not delete allocation "+key+": it is assigned to a server. Set force_delete_assigned to remove it from the server first.",
+			)
+			return nil
+		}
+		if allocation.Assigned {
+			r.unassignAllocation(ctx, diags, allocation)
+			if diags.HasError() {
+				return nil
+			}
+		}
+
+		err := deleteNodeAllocation(ctx, r.client, plan.ID.ValueInt32(), allocation.ID)
+		if err != nil {
+			diags.AddError(
+				"Error deleting node allocation",
+				contextErrorDetail(ctx, "deleting allocation "+allocation.IP+":"+strconv.Itoa(int(allocation.Port)), "Could not delete node allocation, unexpected error: "+err.Error()),
+			)
+			return nil
+		}
+		existing[key] = false
+	}
+
+	// Create new allocations
+	missing := []Allocation{}
+	for _, allocation := range plan.Allocations {
+		if !existing[allocationKey(allocation.IP.ValueString(), allocation.Port.ValueInt32())] {
+			missing = append(missing, allocation)
+		}
+	}
+	r.createAllocations(ctx, diags, plan.ID.ValueInt32(), missing)
+	if diags.HasError() {
+		return nil
+	}
+
+	nodeAllocations, err = getNodeAllocations(ctx, r.client, plan.ID.ValueInt32())
+	if err != nil {
+		diags.AddError(
+			"Error Updating Pterodactyl Node Allocations",
+			contextErrorDetail(ctx, "reading the node allocations", "Could not update node allocations: "+err.Error()),
+		)
+		return nil
+	}
+
+	// Notes are changed through the client API, which needs the servers the
+	// allocations are assigned to
+	if r.updateAllocationNotes(ctx, diags, plan.Allocations, nodeAllocations) {
+		nodeAllocations, err = getNodeAllocations(ctx, r.client, plan.ID.ValueInt32())
+		if err != nil {
+			diags.AddError(
+				"Error Updating Pterodactyl Node Allocations",
+				contextErrorDetail(ctx, "reading the node allocations", "Could not update node allocations: "+err.Error()),
+			)
+			return nil
+		}
+	}
+	if diags.HasError() {
+		return nil
+	}
+
+	return nodeAllocationsFromAPI(nodeAllocations)
+}
+
+// updateAllocationNotes changes the notes of the allocations whose planned
+// notes differ from the ones in the panel and reports whether any changed.
+// The client API only reaches allocations assigned to a server, the notes of
+// the others cannot be changed.
+func (r *nodeResource) updateAllocationNotes(ctx context.Context, diags *diag.Diagnostics, planned []Allocation, nodeAllocations []apiAllocation) bool {
+	existing := make(map[string]apiAllocation, len(nodeAllocations))
+	for _, allocation := range nodeAllocations {
+		existing[allocationKey(allocation.IP, allocation.Port)] = allocation
+	}
+
+	changed := false
+	for _, plannedAllocation := range planned {
+		key := allocationKey(plannedAllocation.IP.ValueString(), plannedAllocation.Port.ValueInt32())
+		allocation, ok := existing[key]
+		if !ok || !allocationFieldChanged(plannedAllocation.Notes, allocation.Notes) {
+			continue
+		}
+
+		if allocation.serverIdentifier() == "" {
+			diags.AddAttributeError(
+				path.Root("allocations"),
+				"Error updating node allocation notes",
+				"Could not change the notes of allocation "+key+": the panel only allows changing them through the client API "+
+					"once the allocation is assigned to a server.",
+			)
+			continue
+		}
+
+		client := r.clients.clientAPI("Allocation notes", diags)
+		if client == nil {
+			return changed
+		}
+
+		err := updateServerAllocationNotes(ctx, client, allocation.serverIdentifier(), allocation.ID, plannedAllocation.Notes.ValueStringPointer())
+		if err != nil {
+			addAPIError(diags, apiFieldPaths{"notes": path.Root("allocations")}, err,
+				"Error updating node allocation notes",
+				contextErrorDetail(ctx, "updating the notes of allocation "+key, "Could not update node allocation notes, unexpected error: "+err.Error()),
+			)
+			return changed
+		}
+		changed = true
+	}
+
+	return changed
+}
+
+// allocationFieldChanged reports whether the planned value of an optional
+// allocation field differs from the one in the panel. Unknown values are
+// not managed.
+func allocationFieldChanged(planned types.String, current *string) bool {
+	return !planned.IsUnknown() && !planned.Equal(types.StringPointerValue(current))
+}
+
+// createAllocations creates allocations with one request per IP and alias,
+// as the panel creates all ports of a request with the same alias.
+func (r *nodeResource) createAllocations(ctx context.Context, diags *diag.Diagnostics, nodeID int32, allocations []Allocation) {
+	type batchKey struct {
+		ip    string
+		alias types.String
+	}
+
+	var batches []batchKey
+	ports := make(map[batchKey][]int32)
+	for _, allocation := range allocations {
+		key := batchKey{ip: allocation.IP.ValueString(), alias: allocation.Alias}
+		if _, ok := ports[key]; !ok {
+			batches = append(batches, key)
+		}
+		ports[key] = append(ports[key], allocation.Port.ValueInt32())
+	}
+
+	for _, key := range batches {
+		// Stop early when the operation has been cancelled
+		if err := ctx.Err(); err != nil {
+			diags.AddError(
+				"Error creating node allocation",
+				contextErrorDetail(ctx, "creating the allocations of "+key.ip, "Could not create node allocations: "+err.Error()),
+			)
+			return
+		}
+
+		slices.Sort(ports[key])
+		partialAllocation := apiPartialAllocation{
+			IP:    key.ip,
+			Ports: compactPorts(ports[key]),
+		}
+		if !key.alias.IsUnknown() {
+			partialAllocation.Alias = key.alias.ValueStringPointer()
+		}
+
+		// Create new allocations
+		err := createNodeAllocations(ctx, r.client, nodeID, partialAllocation)
+		if err != nil {
+			addAPIError(diags, nodeAllocationAPIFields, err,
+				"Error creating node allocation",
+				contextErrorDetail(ctx, "creating the allocations of "+key.ip, "Could not create node allocations, unexpected error: "+err.Error()),
+			)
+			return
+		}
+	}
+}
+
+// unassignAllocation removes an allocation from the server it is assigned to,
+// as the panel refuses to delete assigned allocations. The default allocation
+// of a server cannot be removed from it.
+func (r *nodeResource) unassignAllocation(ctx context.Context, diags *diag.Diagnostics, allocation apiAllocation) {
+	key := allocationKey(allocation.IP, allocation.Port)
+	if allocation.Relationships.Server.Attributes == nil {
+		diags.AddError(
+			"Error deleting node allocation",
+			"Could not remove allocation "+key+" from its server: the panel did not return the server.",
+		)
+		return
+	}
+
+	server, err := getServer(ctx, r.client, allocation.Relationships.Server.Attributes.ID)
+	if err != nil {
+		diags.AddError(
+			"Error deleting node allocation",
+			contextErrorDetail(ctx, "reading the server of allocation "+key, "Could not read the server of node allocation, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	if server.Allocation == allocation.ID {
+		diags.AddAttributeError(
+			path.Root("allocations"),
+			"Error deleting node allocation",
+			"Could not delete allocation "+key+": it is the default allocation of server "+server.Name+". "+
+				"Change the default allocation of the server first.",
+		)
+		return
+	}
+
+	_, err = updateServerBuild(ctx, r.client, server.ID, apiServerBuild{
+		Allocation:        server.Allocation,
+		OOMDisabled:       server.Limits.OOMDisabled,
+		Limits:            server.Limits,
+		FeatureLimits:     server.FeatureLimits,
+		RemoveAllocations: []int32{allocation.ID},
+	})
+	if err != nil {
+		diags.AddError(
+			"Error deleting node allocation",
+			contextErrorDetail(ctx, "removing allocation "+key+" from server "+server.Name, "Could not remove node allocation from its server, unexpected error: "+err.Error()),
+		)
+		return
+	}
+}
+
+// nodeAllocationsFromAPI maps the allocations of a node onto the schema.
+func nodeAllocationsFromAPI(nodeAllocations []apiAllocation) []Allocation {
+	allocations := make([]Allocation, len(nodeAllocations))
+	for i, allocation := range nodeAllocations {
+		allocations[i] = Allocation{
+			ID:       types.Int32Value(allocation.ID),
+			IP:       types.StringValue(allocation.IP),
+			Alias:    types.StringPointerValue(allocation.Alias),
+			Port:     types.Int32Value(allocation.Port),
+			Notes:    types.StringPointerValue(allocation.Notes),
+			Assigned: types.BoolValue(allocation.Assigned),
+		}
+	}
+
+	return allocations
+}
@@ -0,0 +1,406 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeResource(t *testing.T) {
+	panel := newFakePanel(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             panel.testAccCheckDestroyed,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(panel) + testAccNodeResourceConfig("node-1", "node1.example.com", 25566, "play.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pterodactyl_node.test", "name", "node-1"),
+					resource.TestCheckResourceAttr("pterodactyl_node.test", "fqdn", "node1.example.com"),
+					resource.TestCheckResourceAttrPair("pterodactyl_node.test", "location_id", "pterodactyl_location.test", "id"),
+					resource.TestCheckResourceAttr("pterodactyl_node.test", "daemon_base", "/var/lib/pterodactyl/volumes"),
+					resource.TestCheckResourceAttr("pterodactyl_node.test", "allocations.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("pterodactyl_node.test", "allocations.*", map[string]string{
+						"ip":    "10.0.0.1",
+						"port":  "25565",
+						"alias": "play.example.com",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("pterodactyl_node.test", "allocations.*", map[string]string{
+						"ip":   "10.0.0.1",
+						"port": "25566",
+					}),
+					resource.TestCheckResourceAttrSet("pterodactyl_node.test", "uuid"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "pterodactyl_node.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by the name
+			{
+				ResourceName:      "pterodactyl_node.test",
+				ImportState:       true,
+				ImportStateId:     "name:node-1",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(panel) + testAccNodeResourceConfig("node-2", "node2.example.com", 25567, "mc.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pterodactyl_node.test", "name", "node-2"),
+					resource.TestCheckResourceAttr("pterodactyl_node.test", "fqdn", "node2.example.com"),
+					resource.TestCheckResourceAttr("pterodactyl_node.test", "allocations.#", "2"),
+					// Unassigned allocations are recreated with the new alias
+					resource.TestCheckTypeSetElemNestedAttrs("pterodactyl_node.test", "allocations.*", map[string]string{
+						"port":  "25565",
+						"alias": "mc.example.com",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("pterodactyl_node.test", "allocations.*", map[string]string{
+						"port": "25567",
+					}),
+				),
+			},
+			// Validation errors of the panel are reported on the attribute
+			{
+				Config:      testAccProviderConfig(panel) + testAccNodeResourceConfig("node-2", "node2.example.com", 80, "mc.example.com"),
+				ExpectError: regexp.MustCompile(`Ports\s+in\s+an\s+allocation\s+must\s+be\s+greater\s+than\s+1024`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNodeResourceCreateAllocationsFailed(t *testing.T) {
+	panel := newFakePanel(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// The node is saved before its allocations are created, so it is
+		// destroyed instead of being left behind in the panel
+		CheckDestroy: panel.testAccCheckDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(panel) + testAccNodeResourceConfig("node-1", "node1.example.com", 80, "play.example.com"),
+				ExpectError: regexp.MustCompile(`Ports\s+in\s+an\s+allocation\s+must\s+be\s+greater\s+than\s+1024`),
+			},
+		},
+	})
+}
+
+func testAccNodeResourceConfig(name, fqdn string, port int, alias string) string {
+	return fmt.Sprintf(`
+resource "pterodactyl_location" "test" {
+  short = "test"
+  long  = "Test location"
+}
+
+resource "pterodactyl_node" "test" {
+  name                = %[1]q
+  description         = "Managed by Terraform"
+  public              = true
+  behind_proxy        = false
+  maintenance_mode    = false
+  location_id         = pterodactyl_location.test.id
+  fqdn                = %[2]q
+  scheme              = "https"
+  memory              = 8192
+  memory_overallocate = 0
+  disk                = 102400
+  disk_overallocate   = 0
+  upload_size         = 100
+  daemon_sftp         = 2022
+  daemon_listen       = 8080
+
+  allocations = [
+    {
+      ip    = "10.0.0.1"
+      port  = 25565
+      alias = %[4]q
+    },
+    {
+      ip   = "10.0.0.1"
+      port = %[3]d
+    },
+  ]
+}
+`, name, fqdn, port, alias)
+}
+
+func TestNodeResourceRead(t *testing.T) {
+	testCases := map[string]struct {
+		fixture    string
+		locationID int32
+		createdAt  string
+		updatedAt  string
+	}{
+		"pterodactyl": {
+			fixture:    "node_resource_read",
+			locationID: 3,
+			createdAt:  "2024-01-03T14:00:00Z",
+			updatedAt:  "2024-04-02T09:30:00Z",
+		},
+		// Pelican has no locations, its nodes come without a location ID.
+		"pelican": {
+			fixture:   "node_resource_read_pelican",
+			createdAt: "2024-06-03T14:00:00Z",
+			updatedAt: "2024-06-04T09:30:00Z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// Only allocations managed by the resource are read.
+			allocationsType := replayResourceType(t, NewNodeResource()).AttributeTypes["allocations"]
+			state := replayResourceRead(t, NewNodeResource(), testCase.fixture, map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.Number, 2),
+				"allocations": tftypes.NewValue(allocationsType, []tftypes.Value{}),
+			})
+
+			var node nodeResourceModel
+			replayStateGet(t, state, &node)
+
+			replayCheck(t, "id", node.ID, types.Int32Value(2))
+			replayCheck(t, "uuid", node.UUID, types.StringValue("9f0e3c1a-6b2d-4c8e-a1f0-2d3e4f5a6b7c"))
+			replayCheck(t, "name", node.Name, types.StringValue("fra-1"))
+			replayCheck(t, "description", node.Description, types.StringValue("Game servers in Frankfurt"))
+			replayCheck(t, "public", node.Public, types.BoolValue(true))
+			replayCheck(t, "location_id", node.LocationID, types.Int32Value(testCase.locationID))
+			replayCheck(t, "fqdn", node.FQDN, types.StringValue("fra-1.example.com"))
+			replayCheck(t, "scheme", node.Scheme, types.StringValue("https"))
+			replayCheck(t, "memory", node.Memory, types.Int32Value(32768))
+			replayCheck(t, "disk_overallocate", node.DiskOverallocate, types.Int32Value(-1))
+			replayCheck(t, "daemon_sftp", node.DaemonSFTP, types.Int32Value(2022))
+			replayCheck(t, "daemon_listen", node.DaemonListen, types.Int32Value(8080))
+			replayCheck(t, "daemon_base", node.DaemonBase, types.StringValue("/var/lib/pterodactyl/volumes"))
+			replayCheck(t, "created_at", node.CreatedAt, types.StringValue(testCase.createdAt))
+			replayCheck(t, "updated_at", node.UpdatedAt, types.StringValue(testCase.updatedAt))
+
+			if len(node.Allocations) != 2 {
+				t.Fatalf("expected 2 allocations, got %d", len(node.Allocations))
+			}
+			replayCheck(t, "allocations.0.id", node.Allocations[0].ID, types.Int32Value(11))
+			replayCheck(t, "allocations.0.port", node.Allocations[0].Port, types.Int32Value(25565))
+			replayCheck(t, "allocations.0.alias", node.Allocations[0].Alias, types.StringNull())
+			replayCheck(t, "allocations.0.assigned", node.Allocations[0].Assigned, types.BoolValue(true))
+			replayCheck(t, "allocations.1.ip", node.Allocations[1].IP, types.StringValue("10.0.0.1"))
+			replayCheck(t, "allocations.1.alias", node.Allocations[1].Alias, types.StringValue("play.example.com"))
+			replayCheck(t, "allocations.1.notes", node.Allocations[1].Notes, types.StringValue("Reserved for events"))
+		})
+	}
+}
+
+func TestNodeResourceAllocationNotes(t *testing.T) {
+	notes := "Reserved for events"
+	nodeAllocations := []apiAllocation{
+		{ID: 11, IP: "10.0.0.1", Port: 25565, Assigned: true},
+		{ID: 12, IP: "10.0.0.1", Port: 25566, Notes: &notes},
+	}
+	nodeAllocations[0].Relationships.Server.Attributes = &apiAllocationServer{Identifier: "c3d4e5f6"}
+
+	testCases := map[string]struct {
+		planned []Allocation
+		err     string
+	}{
+		"unchanged": {
+			planned: []Allocation{
+				{IP: types.StringValue("10.0.0.1"), Port: types.Int32Value(25565), Notes: types.StringNull()},
+				{IP: types.StringValue("10.0.0.1"), Port: types.Int32Value(25566), Notes: types.StringValue(notes)},
+			},
+		},
+		"unmanaged": {
+			planned: []Allocation{
+				{IP: types.StringValue("10.0.0.1"), Port: types.Int32Value(25566), Notes: types.StringUnknown()},
+			},
+		},
+		// Unassigned allocations are out of reach of the client API.
+		"unassigned": {
+			planned: []Allocation{
+				{IP: types.StringValue("10.0.0.1"), Port: types.Int32Value(25566), Notes: types.StringNull()},
+			},
+			err: "Error updating node allocation notes",
+		},
+		"without client API key": {
+			planned: []Allocation{
+				{IP: types.StringValue("10.0.0.1"), Port: types.Int32Value(25565), Notes: types.StringValue("Lobby")},
+			},
+			err: "Missing Pterodactyl Panel Client API Key",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := &nodeResource{clients: &pterodactylClients{}}
+
+			var diags diag.Diagnostics
+			if r.updateAllocationNotes(context.Background(), &diags, testCase.planned, nodeAllocations) {
+				t.Error("expected no notes to be changed")
+			}
+
+			switch {
+			case testCase.err == "" && diags.HasError():
+				t.Errorf("unexpected errors: %v", diags.Errors())
+			case testCase.err != "" && (!diags.HasError() || diags.Errors()[0].Summary() != testCase.err):
+				t.Errorf("expected error %q, got %v", testCase.err, diags.Errors())
+			}
+		})
+	}
+}
+
+func TestNodeResourceAllocations(t *testing.T) {
+	ctx := context.Background()
+	r := &nodeResource{client: newReplayClients(t, "node_resource_allocations").Application}
+
+	// Creations are batched per IP and alias, with consecutive ports as ranges.
+	var diags diag.Diagnostics
+	r.createAllocations(ctx, &diags, 2, []Allocation{
+		{IP: types.StringValue("10.0.0.1"), Port: types.Int32Value(25600), Alias: types.StringUnknown()},
+		{IP: types.StringValue("10.0.0.2"), Port: types.Int32Value(27015), Alias: types.StringValue("play.example.com")},
+		{IP: types.StringValue("10.0.0.1"), Port: types.Int32Value(25566), Alias: types.StringUnknown()},
+		{IP: types.StringValue("10.0.0.1"), Port: types.Int32Value(25565), Alias: types.StringUnknown()},
+		{IP: types.StringValue("10.0.0.1"), Port: types.Int32Value(25567), Alias: types.StringUnknown()},
+	})
+	if diags.HasError() {
+		t.Fatalf("creating the allocations: %v", diags)
+	}
+
+	// Additional allocations are taken from their server before deletion.
+	allocation := apiAllocation{ID: 12, IP: "10.0.0.1", Port: 25566, Assigned: true}
+	allocation.Relationships.Server.Attributes = &apiAllocationServer{ID: 9, Identifier: "c3d4e5f6"}
+	r.unassignAllocation(ctx, &diags, allocation)
+	if diags.HasError() {
+		t.Fatalf("unassigning the allocation: %v", diags)
+	}
+}
+
+func TestNodeResourceModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := NewNodeResource().(*nodeResource)
+
+	var schemaResp frameworkresource.SchemaResponse
+	r.Schema(ctx, frameworkresource.SchemaRequest{}, &schemaResp)
+	nodeType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	allocationsType := nodeType.AttributeTypes["allocations"].(tftypes.Set)
+
+	allocation := func(port int, assigned bool) tftypes.Value {
+		return replayObject(t, allocationsType.ElementType, map[string]tftypes.Value{
+			"ip":       tftypes.NewValue(tftypes.String, "10.0.0.1"),
+			"port":     tftypes.NewValue(tftypes.Number, port),
+			"assigned": tftypes.NewValue(tftypes.Bool, assigned),
+		})
+	}
+	aliasedAllocation := func(port int, alias string) tftypes.Value {
+		return replayObject(t, allocationsType.ElementType, map[string]tftypes.Value{
+			"ip":    tftypes.NewValue(tftypes.String, "10.0.0.1"),
+			"port":  tftypes.NewValue(tftypes.Number, port),
+			"alias": tftypes.NewValue(tftypes.String, alias),
+		})
+	}
+	notedAllocation := func(port int, notes string) tftypes.Value {
+		return replayObject(t, allocationsType.ElementType, map[string]tftypes.Value{
+			"ip":    tftypes.NewValue(tftypes.String, "10.0.0.1"),
+			"port":  tftypes.NewValue(tftypes.Number, port),
+			"notes": tftypes.NewValue(tftypes.String, notes),
+		})
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw: replayObject(t, nodeType, map[string]tftypes.Value{
+			"allocations": tftypes.NewValue(allocationsType, []tftypes.Value{allocation(25565, true), allocation(25566, false)}),
+		}),
+	}
+
+	testCases := map[string]struct {
+		allocations []tftypes.Value
+		create      bool
+		unmanaged   bool
+		force       bool
+		err         bool
+	}{
+		"unassigned removed": {
+			allocations: []tftypes.Value{allocation(25565, true)},
+		},
+		"assigned removed": {
+			allocations: []tftypes.Value{allocation(25566, false)},
+			err:         true,
+		},
+		"assigned removed by force": {
+			allocations: []tftypes.Value{allocation(25566, false)},
+			force:       true,
+		},
+		"unassigned alias changed": {
+			allocations: []tftypes.Value{allocation(25565, true), aliasedAllocation(25566, "play.example.com")},
+		},
+		// Changing the alias recreates the allocation, which is never forced.
+		"assigned alias changed": {
+			allocations: []tftypes.Value{aliasedAllocation(25565, "play.example.com"), allocation(25566, false)},
+			force:       true,
+			err:         true,
+		},
+		// Notes can only be set once the allocation is assigned to a server.
+		"assigned notes changed": {
+			allocations: []tftypes.Value{notedAllocation(25565, "Lobby"), allocation(25566, false)},
+		},
+		"unassigned notes changed": {
+			allocations: []tftypes.Value{allocation(25565, true), notedAllocation(25566, "Lobby")},
+			err:         true,
+		},
+		"new allocation with notes": {
+			allocations: []tftypes.Value{allocation(25565, true), allocation(25566, false), notedAllocation(25567, "Lobby")},
+			err:         true,
+		},
+		"created with notes": {
+			allocations: []tftypes.Value{notedAllocation(25565, "Lobby")},
+			create:      true,
+			err:         true,
+		},
+		"created without notes": {
+			allocations: []tftypes.Value{allocation(25565, false)},
+			create:      true,
+		},
+		// Allocations left unmanaged are never deleted.
+		"unmanaged": {
+			unmanaged: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			allocations := tftypes.NewValue(allocationsType, testCase.allocations)
+			if testCase.unmanaged {
+				allocations = tftypes.NewValue(allocationsType, nil)
+			}
+
+			plan := tfsdk.Plan{
+				Schema: schemaResp.Schema,
+				Raw: replayObject(t, nodeType, map[string]tftypes.Value{
+					"allocations":           allocations,
+					"force_delete_assigned": tftypes.NewValue(tftypes.Bool, testCase.force),
+				}),
+			}
+
+			state := state
+			if testCase.create {
+				state.Raw = tftypes.NewValue(nodeType, nil)
+			}
+
+			resp := frameworkresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, frameworkresource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() != testCase.err {
+				t.Errorf("expected error %t, got %v", testCase.err, resp.Diagnostics)
+			}
+		})
+	}
+}
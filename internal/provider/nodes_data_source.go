@@ -7,6 +7,7 @@ import (
 
 	"github.com/Luiggi33/pterodactyl-client-go"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -31,8 +32,12 @@ type nodesDataSource struct {
 
 // nodesDataSourceModel maps the data source schema data.
 type nodesDataSourceModel struct {
-	LocationID types.Int32 `tfsdk:"location_id"`
-	Nodes      []Node      `tfsdk:"nodes"`
+	LocationID types.Int32  `tfsdk:"location_id"`
+	Name       types.String `tfsdk:"name"`
+	UUID       types.String `tfsdk:"uuid"`
+	FQDN       types.String `tfsdk:"fqdn"`
+	Sort       types.String `tfsdk:"sort"`
+	Nodes      []Node       `tfsdk:"nodes"`
 }
 
 // Node schema data.
@@ -70,12 +75,40 @@ func (d *nodesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 		Description: "The Pterodactyl nodes data source allows Terraform to read nodes from the Pterodactyl API.",
 		Attributes: map[string]schema.Attribute{
 			"location_id": schema.Int32Attribute{
-				Description: "The ID of the location.",
+				Description: "Only return the nodes in the location with this ID.",
 				Optional:    true,
 				Validators: []validator.Int32{
 					int32validator.AtLeast(1),
 				},
 			},
+			"name": schema.StringAttribute{
+				Description: "Only return the nodes with this name.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "Only return the node with this UUID.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"fqdn": schema.StringAttribute{
+				Description: "Only return the nodes with this FQDN.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Description: "The attribute the nodes are sorted by, id, uuid, memory or disk, prefixed with - to sort in descending order, e.g. -memory. Defaults to the order of the panel.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(sortValues("id", "uuid", "memory", "disk")...),
+				},
+			},
 			"nodes": schema.ListNestedAttribute{
 				Description: "The list of nodes.",
 				Computed:    true,
@@ -172,7 +205,22 @@ func (d *nodesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 func (d *nodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state nodesDataSourceModel
 
-	nodes, err := d.client.GetNodes()
+	// Get the filters from the request
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The panel cannot filter nodes by their location, so location_id is
+	// only compared below.
+	query := listQuery(map[string]types.String{
+		"name": state.Name,
+		"uuid": state.UUID,
+		"fqdn": state.FQDN,
+	}, state.Sort)
+
+	nodes, err := getNodes(ctx, d.client, query)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Pterodactyl Nodes",
@@ -181,18 +229,17 @@ func (d *nodesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// suboptimal, but at least somewhat more efficient
-	if state.LocationID.IsNull() {
-		state.Nodes = make([]Node, 0, len(nodes))
-	} else {
-		state.Nodes = make([]Node, 0)
-	}
-
 	// Map response body to model
+	state.Nodes = make([]Node, 0, len(nodes))
 	for _, node := range nodes {
 		if !state.LocationID.IsNull() && node.LocationID != state.LocationID.ValueInt32() {
 			continue
 		}
+		if !matchesFilter(state.Name, node.Name) ||
+			!matchesFilter(state.UUID, node.UUID) ||
+			!matchesFilter(state.FQDN, node.FQDN) {
+			continue
+		}
 		state.Nodes = append(state.Nodes, Node{
 			ID:                 types.Int32Value(node.ID),
 			UUID:               types.StringValue(node.UUID),
@@ -218,7 +265,7 @@ func (d *nodesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -233,15 +280,15 @@ func (d *nodesDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*pterodactyl.Client)
+	clients, ok := req.ProviderData.(*pterodactylClients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *pterodactyl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = clients.Application
 }
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNodesDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewNodesDataSource(), "nodes_data_source_read", nil)
+
+	var nodes nodesDataSourceModel
+	replayStateGet(t, state, &nodes)
+
+	if len(nodes.Nodes) != 2 {
+		t.Fatalf("expected 2 nodes, got %d", len(nodes.Nodes))
+	}
+
+	replayCheck(t, "nodes.0.id", nodes.Nodes[0].ID, types.Int32Value(2))
+	replayCheck(t, "nodes.0.name", nodes.Nodes[0].Name, types.StringValue("fra-1"))
+	replayCheck(t, "nodes.0.location_id", nodes.Nodes[0].LocationID, types.Int32Value(3))
+	replayCheck(t, "nodes.0.disk_overallocate", nodes.Nodes[0].DiskOverallocate, types.Int32Value(-1))
+	replayCheck(t, "nodes.0.updated_at", nodes.Nodes[0].UpdatedAt, types.StringValue("2024-04-02T09:30:00Z"))
+	replayCheck(t, "nodes.1.name", nodes.Nodes[1].Name, types.StringValue("nyc-1"))
+	replayCheck(t, "nodes.1.fqdn", nodes.Nodes[1].FQDN, types.StringValue("nyc-1.example.com"))
+	replayCheck(t, "nodes.1.public", nodes.Nodes[1].Public, types.BoolValue(false))
+}
+
+func TestNodesDataSourceReadFiltered(t *testing.T) {
+	state := replayDataSourceRead(t, NewNodesDataSource(), "nodes_data_source_read_filtered", map[string]tftypes.Value{
+		"location_id": tftypes.NewValue(tftypes.Number, 3),
+		"name":        tftypes.NewValue(tftypes.String, "fra-1"),
+		"sort":        tftypes.NewValue(tftypes.String, "memory"),
+	})
+
+	var nodes nodesDataSourceModel
+	replayStateGet(t, state, &nodes)
+
+	// The panel also returns fra-10 for filter[name], and the other fra-1 is
+	// in location 4.
+	if len(nodes.Nodes) != 1 {
+		t.Fatalf("expected 1 node, got %d", len(nodes.Nodes))
+	}
+
+	replayCheck(t, "nodes.0.id", nodes.Nodes[0].ID, types.Int32Value(2))
+	replayCheck(t, "nodes.0.name", nodes.Nodes[0].Name, types.StringValue("fra-1"))
+	replayCheck(t, "nodes.0.location_id", nodes.Nodes[0].LocationID, types.Int32Value(3))
+}
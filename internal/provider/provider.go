@@ -2,14 +2,22 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -21,8 +29,19 @@ var (
 
 // pterodactylProviderModel maps provider schema data to a Go type.
 type pterodactylProviderModel struct {
-	Host   types.String `tfsdk:"host"`
-	ApiKey types.String `tfsdk:"api_key"`
+	Host               types.String `tfsdk:"host"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	ClientApiKey       types.String `tfsdk:"client_api_key"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	MaxRetries         types.Int32  `tfsdk:"max_retries"`
+	RetryMinWait       types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait       types.String `tfsdk:"retry_max_wait"`
+	RequestsPerMinute  types.Int32  `tfsdk:"requests_per_minute"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
 }
 
 // pterodactylProvider is the provider implementation.
@@ -31,6 +50,10 @@ type pterodactylProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// transport replaces the HTTP transport talking to the panel, it is only
+	// set by tests replaying recorded responses.
+	transport http.RoundTripper
 }
 
 func New(version string) func() provider.Provider {
@@ -57,10 +80,64 @@ func (p *pterodactylProvider) Schema(_ context.Context, _ provider.SchemaRequest
 				Optional:    true,
 			},
 			"api_key": schema.StringAttribute{
-				Description: "The Pterodactyl Panel API key.",
+				Description: "The Pterodactyl Panel application API key (ptla_).",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"client_api_key": schema.StringAttribute{
+				Description: "The Pterodactyl Panel client API key (ptlc_), only required by resources and data sources using the client API.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates trusted in addition to the system roots when connecting to the Pterodactyl Panel.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded CA bundle trusted in addition to the system roots when connecting to the Pterodactyl Panel.",
+				Optional:    true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded client certificate presented to the Pterodactyl Panel, for reverse proxies enforcing mutual TLS.",
+				Optional:    true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Disable the verification of the TLS certificate of the Pterodactyl Panel. Only use this for testing.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int32Attribute{
+				Description: "The maximum amount of times a request failing with a transient error is retried. POST and PATCH requests are only retried when rate limited, as the panel may have processed them already. Defaults to 3.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"retry_min_wait": schema.StringAttribute{
+				Description: "The minimum time to wait before retrying a request, as a duration string like \"500ms\". Defaults to \"1s\".",
+				Optional:    true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Description: "The maximum time to wait before retrying a request, as a duration string like \"1m\". Defaults to \"30s\".",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "The timeout of a single request to the Pterodactyl Panel, as a duration string like \"30s\". " +
+					"Retries get a fresh timeout each. Defaults to \"10s\".",
+				Optional: true,
+			},
+			"requests_per_minute": schema.Int32Attribute{
+				Description: "The maximum amount of requests per minute sent to the Pterodactyl Panel, shared by all resources and data sources. " +
+					"Defaults to 240, the default rate limit of the panel. Set to 0 to disable client-side rate limiting.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -97,6 +174,15 @@ func (p *pterodactylProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
+	if config.ClientApiKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_api_key"),
+			"Unknown Pterodactyl Panel Client API Key",
+			"The provider requires a known value for the Pterodactyl Panel client API key. "+
+				"Set the client_api_key value in the configuration or use the PTERODACTYL_CLIENT_API_KEY environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -106,6 +192,12 @@ func (p *pterodactylProvider) Configure(ctx context.Context, req provider.Config
 
 	host := os.Getenv("PTERODACTYL_HOST")
 	apiKey := os.Getenv("PTERODACTYL_API_KEY")
+	clientApiKey := os.Getenv("PTERODACTYL_CLIENT_API_KEY")
+	caCertPEM := os.Getenv("PTERODACTYL_CA_CERT_PEM")
+	caCertFile := os.Getenv("PTERODACTYL_CA_CERT_FILE")
+	clientCertPEM := os.Getenv("PTERODACTYL_CLIENT_CERT_PEM")
+	clientKeyPEM := os.Getenv("PTERODACTYL_CLIENT_KEY_PEM")
+	insecureSkipVerify, _ := strconv.ParseBool(os.Getenv("PTERODACTYL_INSECURE_SKIP_VERIFY"))
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
@@ -115,6 +207,30 @@ func (p *pterodactylProvider) Configure(ctx context.Context, req provider.Config
 		apiKey = config.ApiKey.ValueString()
 	}
 
+	if !config.ClientApiKey.IsNull() {
+		clientApiKey = config.ClientApiKey.ValueString()
+	}
+
+	if !config.CACertPEM.IsNull() {
+		caCertPEM = config.CACertPEM.ValueString()
+	}
+
+	if !config.CACertFile.IsNull() {
+		caCertFile = config.CACertFile.ValueString()
+	}
+
+	if !config.ClientCertPEM.IsNull() {
+		clientCertPEM = config.ClientCertPEM.ValueString()
+	}
+
+	if !config.ClientKeyPEM.IsNull() {
+		clientKeyPEM = config.ClientKeyPEM.ValueString()
+	}
+
+	if !config.InsecureSkipVerify.IsNull() {
+		insecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -138,13 +254,181 @@ func (p *pterodactylProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
+	// Catch keys that were mixed up, the panel would only answer every
+	// request with an unhelpful 403.
+
+	if strings.HasPrefix(apiKey, clientApiKeyPrefix) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Invalid Pterodactyl Panel API Key",
+			"The api_key value is a client API key ("+clientApiKeyPrefix+"), but an application API key ("+applicationApiKeyPrefix+") is required. "+
+				"Create an application API key in the admin area of the panel, "+
+				"client API keys belong in the client_api_key value or the PTERODACTYL_CLIENT_API_KEY environment variable.",
+		)
+	} else if apiKey != "" && !strings.HasPrefix(apiKey, applicationApiKeyPrefix) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("api_key"),
+			"Unexpected Pterodactyl Panel API Key Format",
+			"The api_key value does not start with "+applicationApiKeyPrefix+" like the application API keys of current panel versions. "+
+				"Requests will fail if it is not an application API key.",
+		)
+	}
+
+	if strings.HasPrefix(clientApiKey, applicationApiKeyPrefix) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_api_key"),
+			"Invalid Pterodactyl Panel Client API Key",
+			"The client_api_key value is an application API key ("+applicationApiKeyPrefix+"), but a client API key ("+clientApiKeyPrefix+") is required. "+
+				"Create a client API key in the account settings of the panel.",
+		)
+	} else if clientApiKey != "" && !strings.HasPrefix(clientApiKey, clientApiKeyPrefix) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("client_api_key"),
+			"Unexpected Pterodactyl Panel Client API Key Format",
+			"The client_api_key value does not start with "+clientApiKeyPrefix+" like the client API keys of current panel versions. "+
+				"Requests will fail if it is not a client API key.",
+		)
+	}
+
+	// Build the TLS configuration used for every request to the panel.
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+
+	if caCertPEM != "" || caCertFile != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if caCertPEM != "" && !rootCAs.AppendCertsFromPEM([]byte(caCertPEM)) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_pem"),
+				"Invalid Pterodactyl Panel CA Certificate",
+				"The ca_cert_pem value does not contain any PEM encoded certificate.",
+			)
+		}
+
+		if caCertFile != "" {
+			bundle, err := os.ReadFile(caCertFile)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("ca_cert_file"),
+					"Unable to Read Pterodactyl Panel CA Bundle",
+					"The provider cannot read the CA bundle "+caCertFile+": "+err.Error(),
+				)
+			} else if !rootCAs.AppendCertsFromPEM(bundle) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("ca_cert_file"),
+					"Invalid Pterodactyl Panel CA Bundle",
+					"The CA bundle "+caCertFile+" does not contain any PEM encoded certificate.",
+				)
+			}
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if clientCertPEM != "" || clientKeyPEM != "" {
+		if clientCertPEM == "" || clientKeyPEM == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_cert_pem"),
+				"Incomplete Pterodactyl Panel Client Certificate",
+				"Both client_cert_pem and client_key_pem (or the PTERODACTYL_CLIENT_CERT_PEM and PTERODACTYL_CLIENT_KEY_PEM environment variables) must be set to use a client certificate.",
+			)
+		} else {
+			certificate, err := tls.X509KeyPair([]byte(clientCertPEM), []byte(clientKeyPEM))
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("client_cert_pem"),
+					"Invalid Pterodactyl Panel Client Certificate",
+					"The provider cannot load the client certificate: "+err.Error(),
+				)
+			}
+			tlsConfig.Certificates = []tls.Certificate{certificate}
+		}
+	}
+
+	if insecureSkipVerify {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"Pterodactyl Panel TLS Verification Disabled",
+			"The provider does not verify the TLS certificate of the Pterodactyl Panel. "+
+				"Anyone able to intercept the connection can read and modify every request, including the API keys. "+
+				"Trust the certificate through ca_cert_pem or ca_cert_file instead.",
+		)
+	}
+
+	maxRetries := defaultMaxRetries
+	retryMinWait := defaultRetryMinWait
+	retryMaxWait := defaultRetryMaxWait
+
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt32())
+	}
+
+	if !config.RetryMinWait.IsNull() {
+		wait, err := time.ParseDuration(config.RetryMinWait.ValueString())
+		if err != nil || wait < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_min_wait"),
+				"Invalid Retry Minimum Wait",
+				"The retry_min_wait value must be a non-negative duration string like \"500ms\" or \"2s\".",
+			)
+		}
+		retryMinWait = wait
+	}
+
+	if !config.RetryMaxWait.IsNull() {
+		wait, err := time.ParseDuration(config.RetryMaxWait.ValueString())
+		if err != nil || wait < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Maximum Wait",
+				"The retry_max_wait value must be a non-negative duration string like \"30s\" or \"1m\".",
+			)
+		}
+		retryMaxWait = wait
+	}
+
+	requestTimeout := defaultRequestTimeout
+
+	if !config.RequestTimeout.IsNull() {
+		timeout, err := time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				"The request_timeout value must be a positive duration string like \"10s\" or \"1m\".",
+			)
+		}
+		requestTimeout = timeout
+	}
+
+	requestsPerMinute := defaultRequestsPerMinute
+
+	if !config.RequestsPerMinute.IsNull() {
+		requestsPerMinute = int(config.RequestsPerMinute.ValueInt32())
+	}
+
+	if !resp.Diagnostics.HasError() && retryMinWait > retryMaxWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid Retry Wait Range",
+			"The retry_min_wait value must not be greater than the retry_max_wait value.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	ctx = tflog.SetField(ctx, "pterodactyl_host", host)
 	ctx = tflog.SetField(ctx, "pterodactyl_api_key", apiKey)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "pterodactyl_api_key")
+	ctx = tflog.SetField(ctx, "pterodactyl_client_api_key", clientApiKey)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "pterodactyl_api_key", "pterodactyl_client_api_key")
 
 	tflog.Debug(ctx, "Creating Pterodactyl client")
 
@@ -160,10 +444,39 @@ func (p *pterodactylProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	// Make the Pterodactyl client available during DataSource and Resource
+	// Rate limit every attempt and retry requests failing with transient
+	// errors, the per-attempt timeout of the retry transport replaces the
+	// timeout of the default client.
+	var transport http.RoundTripper = newHTTPTransport(tlsConfig)
+	if p.transport != nil {
+		transport = p.transport
+	}
+	if requestsPerMinute > 0 {
+		transport = newRateLimitTransport(transport, requestsPerMinute)
+	}
+
+	client.HTTPClient = &http.Client{
+		Transport: newRetryTransport(transport, maxRetries, retryMinWait, retryMaxWait, requestTimeout),
+	}
+
+	clients := &pterodactylClients{
+		Application: client,
+	}
+
+	// The client API client shares the HTTP client, and with it the
+	// rate limit, with the application API client.
+	if clientApiKey != "" {
+		clients.Client = &pterodactyl.Client{
+			HostURL:    client.HostURL,
+			HTTPClient: client.HTTPClient,
+			Token:      clientApiKey,
+		}
+	}
+
+	// Make the Pterodactyl clients available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = clients
+	resp.ResourceData = clients
 
 	tflog.Info(ctx, "Pterodactyl client created")
 }
@@ -178,8 +491,17 @@ func (p *pterodactylProvider) DataSources(_ context.Context) []func() datasource
 		NewNodesDataSource,
 		NewNodeDataSource,
 		NewNodeAllocationsDataSource,
+		NewNodeConfigurationDataSource,
 		// Location related data sources
 		NewLocationDataSource,
+		NewLocationsDataSource,
+		// Nest and egg related data sources
+		NewNestsDataSource,
+		NewNestDataSource,
+		NewEggsDataSource,
+		NewEggDataSource,
+		// Database host related data sources
+		NewDatabaseHostsDataSource,
 	}
 }
 
@@ -189,5 +511,10 @@ func (p *pterodactylProvider) Resources(_ context.Context) []func() resource.Res
 		NewUserResource,
 		NewNodeResource,
 		NewLocationResource,
+		NewServerResource,
+		NewDatabaseHostResource,
+		NewServerDatabaseResource,
+		NewMountResource,
+		NewAllocationResource,
 	}
 }
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance testing. The factory function will be invoked for every Terraform
+// CLI command executed to create a provider server to which the CLI can
+// reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"pterodactyl": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccProviderConfig returns the provider configuration pointing at panel.
+func testAccProviderConfig(panel *fakePanel) string {
+	return fmt.Sprintf(`
+provider "pterodactyl" {
+  host    = %q
+  api_key = %q
+}
+`, panel.URL, fakePanelAPIKey)
+}
+
+// testAccCheckDestroyed verifies every object has been deleted from the panel.
+func (p *fakePanel) testAccCheckDestroyed(_ *terraform.State) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.users) > 0 || len(p.locations) > 0 || len(p.nodes) > 0 || len(p.allocations) > 0 || len(p.servers) > 0 {
+		return fmt.Errorf("panel still has %d users, %d locations, %d nodes, %d allocations and %d servers",
+			len(p.users), len(p.locations), len(p.nodes), len(p.allocations), len(p.servers))
+	}
+
+	return nil
+}
+
+// testAccDeleteInPanel returns a check function deleting the object of
+// resourceName from objects behind the back of Terraform, as an
+// administrator using the panel would.
+func testAccDeleteInPanel[V any](p *fakePanel, objects map[int32]*V, resourceName string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 32)
+		if err != nil {
+			return fmt.Errorf("resource %s has a non-numeric ID %q", resourceName, rs.Primary.ID)
+		}
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		delete(objects, int32(id))
+		return nil
+	}
+}
+
+func TestProvider(t *testing.T) {
+	if _, err := testAccProtoV6ProviderFactories["pterodactyl"](); err != nil {
+		t.Fatalf("unexpected error creating the provider server: %s", err)
+	}
+}
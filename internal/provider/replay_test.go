@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// replayHost is the panel host used while replaying, it is never dialed.
+const replayHost = "https://panel.example.com"
+
+// Setting PTERODACTYL_RECORD_FIXTURES=1 records the fixtures from the panel
+// configured through PTERODACTYL_HOST and PTERODACTYL_API_KEY instead of
+// replaying them, PTERODACTYL_PANEL describes the panel in the fixtures. The
+// API key is never written to the fixtures.
+const replayRecordEnv = "PTERODACTYL_RECORD_FIXTURES"
+
+// replayFixture is a recorded conversation with a panel, stored as JSON in
+// testdata/fixtures.
+type replayFixture struct {
+	// Panel describes the panel the responses have been recorded from.
+	Panel        string              `json:"panel"`
+	Interactions []replayInteraction `json:"interactions"`
+}
+
+type replayInteraction struct {
+	Request  replayRequest  `json:"request"`
+	Response replayResponse `json:"response"`
+}
+
+type replayRequest struct {
+	Method string `json:"method"`
+	// URL is the path and query of the request.
+	URL  string          `json:"url"`
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+type replayResponse struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// replayTransport is a http.RoundTripper answering the requests of the
+// provider with the responses of a fixture, in order.
+type replayTransport struct {
+	t    testing.TB
+	path string
+
+	mu      sync.Mutex
+	fixture replayFixture
+	next    int
+	// record is the transport to the real panel while recording.
+	record http.RoundTripper
+}
+
+// newReplayTransport loads the fixture at path, verifying at the end of the
+// test that every recorded request has been made.
+func newReplayTransport(t testing.TB, path string) *replayTransport {
+	t.Helper()
+
+	rt := &replayTransport{t: t, path: path}
+
+	if os.Getenv(replayRecordEnv) != "" {
+		rt.record = http.DefaultTransport
+		rt.fixture.Panel = os.Getenv("PTERODACTYL_PANEL")
+		t.Cleanup(rt.save)
+		return rt
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading fixture: %s", err)
+	}
+	if err := json.Unmarshal(b, &rt.fixture); err != nil {
+		t.Fatalf("parsing fixture %s: %s", path, err)
+	}
+
+	t.Cleanup(func() {
+		if unused := len(rt.fixture.Interactions) - rt.next; unused > 0 {
+			t.Errorf("%s: %d recorded requests have not been made, next is %s %s",
+				path, unused, rt.fixture.Interactions[rt.next].Request.Method, rt.fixture.Interactions[rt.next].Request.URL)
+		}
+	})
+
+	return rt
+}
+
+// RoundTrip answers req with the next recorded response.
+func (rt *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	if rt.record != nil {
+		return rt.recordRoundTrip(req, body)
+	}
+
+	if rt.next >= len(rt.fixture.Interactions) {
+		rt.t.Errorf("%s: unexpected request %s %s", rt.path, req.Method, req.URL.RequestURI())
+		return nil, fmt.Errorf("no recorded response left for %s %s", req.Method, req.URL.RequestURI())
+	}
+
+	interaction := rt.fixture.Interactions[rt.next]
+	rt.next++
+
+	if req.Method != interaction.Request.Method || req.URL.RequestURI() != interaction.Request.URL {
+		rt.t.Errorf("%s: request %d is %s %s, recorded %s %s", rt.path, rt.next,
+			req.Method, req.URL.RequestURI(), interaction.Request.Method, interaction.Request.URL)
+		return nil, fmt.Errorf("request does not match the fixture")
+	}
+
+	if len(interaction.Request.Body) > 0 && !jsonEqual(body, interaction.Request.Body) {
+		rt.t.Errorf("%s: request %d has body %s, recorded %s", rt.path, rt.next, body, interaction.Request.Body)
+		return nil, fmt.Errorf("request body does not match the fixture")
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(interaction.Response.Status),
+		StatusCode:    interaction.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// recordRoundTrip sends req to the real panel, recording the response.
+func (rt *replayTransport) recordRoundTrip(req *http.Request, body []byte) (*http.Response, error) {
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	res, err := rt.record.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	interaction := replayInteraction{
+		Request:  replayRequest{Method: req.Method, URL: req.URL.RequestURI()},
+		Response: replayResponse{Status: res.StatusCode},
+	}
+	if json.Valid(body) {
+		interaction.Request.Body = body
+	}
+	if json.Valid(resBody) {
+		interaction.Response.Body = resBody
+	}
+	rt.fixture.Interactions = append(rt.fixture.Interactions, interaction)
+
+	return res, nil
+}
+
+func (rt *replayTransport) save() {
+	b, err := json.MarshalIndent(rt.fixture, "", "  ")
+	if err != nil {
+		rt.t.Errorf("encoding fixture: %s", err)
+		return
+	}
+
+	if err := os.WriteFile(rt.path, append(b, '\n'), 0o644); err != nil {
+		rt.t.Errorf("writing fixture: %s", err)
+	}
+}
+
+func jsonEqual(a, b []byte) bool {
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
+
+// newReplayClients configures the provider with a transport replaying the
+// fixture testdata/fixtures/<name>.json and returns the clients it passes to
+// resources and data sources.
+func newReplayClients(t testing.TB, name string) *pterodactylClients {
+	t.Helper()
+	ctx := context.Background()
+
+	transport := newReplayTransport(t, filepath.Join("testdata", "fixtures", name+".json"))
+	p := &pterodactylProvider{version: "test", transport: transport}
+
+	host, apiKey := replayHost, fakePanelAPIKey
+	if transport.record != nil {
+		host, apiKey = os.Getenv("PTERODACTYL_HOST"), os.Getenv("PTERODACTYL_API_KEY")
+	}
+
+	values := map[string]tftypes.Value{
+		"host":    tftypes.NewValue(tftypes.String, host),
+		"api_key": tftypes.NewValue(tftypes.String, apiKey),
+		// Fail fast instead of retrying requests missing from the fixture.
+		"max_retries": tftypes.NewValue(tftypes.Number, 0),
+	}
+	if transport.record == nil {
+		values["requests_per_minute"] = tftypes.NewValue(tftypes.Number, 0)
+	}
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    replayObject(t, schemaResp.Schema.Type().TerraformType(ctx), values),
+	}
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configuring the provider: %v", resp.Diagnostics)
+	}
+
+	return resp.ResourceData.(*pterodactylClients)
+}
+
+// replayObject returns an object of typ with the given attribute values,
+// every other attribute being null.
+func replayObject(t testing.TB, typ tftypes.Type, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType, ok := typ.(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected an object type, got %s", typ)
+	}
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		if _, ok := attributes[name]; !ok {
+			t.Fatalf("unknown attribute %q", name)
+		}
+		attributes[name] = value
+	}
+
+	return tftypes.NewValue(objectType, attributes)
+}
+
+// replayResourceRead runs Read of r against the fixture name, starting from
+// a state with the given attribute values, and returns the refreshed state.
+func replayResourceRead(t testing.TB, r resource.Resource, name string, state map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	clients := newReplayClients(t, name)
+	if rc, ok := r.(resource.ResourceWithConfigure); ok {
+		var resp resource.ConfigureResponse
+		rc.Configure(ctx, resource.ConfigureRequest{ProviderData: clients}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("configuring the resource: %v", resp.Diagnostics)
+		}
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	current := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    replayObject(t, schemaResp.Schema.Type().TerraformType(ctx), state),
+	}
+
+	resp := resource.ReadResponse{State: current}
+	r.Read(ctx, resource.ReadRequest{State: current}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading the resource: %v", resp.Diagnostics)
+	}
+
+	return resp.State
+}
+
+// replayDataSourceRead runs Read of d against the fixture name with the
+// given configuration and returns the resulting state.
+func replayDataSourceRead(t testing.TB, d datasource.DataSource, name string, config map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	clients := newReplayClients(t, name)
+	if dc, ok := d.(datasource.DataSourceWithConfigure); ok {
+		var resp datasource.ConfigureResponse
+		dc.Configure(ctx, datasource.ConfigureRequest{ProviderData: clients}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("configuring the data source: %v", resp.Diagnostics)
+		}
+	}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    replayObject(t, objectType, config),
+		},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading the data source: %v", resp.Diagnostics)
+	}
+
+	return resp.State
+}
+
+// replayResourceType returns the type of the state of r, to build nested
+// values of the state passed to replayResourceRead.
+func replayResourceType(t testing.TB, r resource.Resource) tftypes.Object {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	return schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+}
+
+// replayStateGet reads state into target, the model of the resource or data
+// source.
+func replayStateGet(t testing.TB, state tfsdk.State, target interface{}) {
+	t.Helper()
+
+	if diags := state.Get(context.Background(), target); diags.HasError() {
+		t.Fatalf("reading the state: %v", diags)
+	}
+}
+
+// replayCheck verifies the value of an attribute read from a replayed state.
+func replayCheck(t testing.TB, name string, got, want attr.Value) {
+	t.Helper()
+
+	if !got.Equal(want) {
+		t.Errorf("%s is %s, expected %s", name, got, want)
+	}
+}
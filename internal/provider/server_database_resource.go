@@ -0,0 +1,473 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serverDatabaseResource{}
+	_ resource.ResourceWithConfigure   = &serverDatabaseResource{}
+	_ resource.ResourceWithImportState = &serverDatabaseResource{}
+	_ resource.ResourceWithModifyPlan  = &serverDatabaseResource{}
+)
+
+// NewServerDatabaseResource is a helper function to simplify the provider implementation.
+func NewServerDatabaseResource() resource.Resource {
+	return &serverDatabaseResource{}
+}
+
+// serverDatabaseResource is the resource implementation.
+type serverDatabaseResource struct {
+	client *pterodactyl.Client
+}
+
+// serverDatabaseResourceModel maps the resource schema data.
+type serverDatabaseResourceModel struct {
+	ID                    types.Int32    `tfsdk:"id"`
+	ServerID              types.Int32    `tfsdk:"server_id"`
+	HostID                types.Int32    `tfsdk:"host_id"`
+	Name                  types.String   `tfsdk:"name"`
+	Remote                types.String   `tfsdk:"remote"`
+	Database              types.String   `tfsdk:"database"`
+	Username              types.String   `tfsdk:"username"`
+	Password              types.String   `tfsdk:"password"`
+	JDBCURL               types.String   `tfsdk:"jdbc_url"`
+	MaxConnections        types.Int32    `tfsdk:"max_connections"`
+	RotatePasswordTrigger types.String   `tfsdk:"rotate_password_trigger"`
+	CreatedAt             types.String   `tfsdk:"created_at"`
+	UpdatedAt             types.String   `tfsdk:"updated_at"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// serverDatabaseAPIFields maps the fields of panel validation errors to the server database schema.
+var serverDatabaseAPIFields = apiFieldPaths{
+	"database": path.Root("name"),
+	"remote":   path.Root("remote"),
+	"host":     path.Root("host_id"),
+}
+
+// Metadata returns the resource type name.
+func (r *serverDatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_database"
+}
+
+// Schema defines the schema for the resource.
+func (r *serverDatabaseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl server database resource allows Terraform to manage the databases of servers in the Pterodactyl Panel API. " +
+			"The panel generates the username and password of the database.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				Description: "The ID of the database.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int32Attribute{
+				Description: "The ID of the server the database belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"host_id": schema.Int32Attribute{
+				Description: "The ID of the database host the database is created on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the database, the panel prefixes it with s<server_id>_.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 48),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[\w-]+$`), "must only contain letters, numbers, dashes and underscores"),
+				},
+			},
+			"remote": schema.StringAttribute{
+				Description: "The hosts the database user may connect from, % allows any host.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("%"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "The full name of the database on the database host.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The username generated for the database.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The password generated for the database.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"jdbc_url": schema.StringAttribute{
+				Description: "The JDBC connection string of the database, without credentials.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_connections": schema.Int32Attribute{
+				Description: "The maximum number of connections to the database, if limited.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_password_trigger": schema.StringAttribute{
+				Description: "An arbitrary value, changing it resets the password of the database.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The creation date of the database.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The last update date of the database.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan marks the password as unknown when it is going to be reset.
+func (r *serverDatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to reset on creation and destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state serverDatabaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotatePasswordTrigger.Equal(state.RotatePasswordTrigger) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password"), types.StringUnknown())...)
+}
+
+// Create a new resource.
+func (r *serverDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan serverDatabaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new database
+	databaseID, err := createServerDatabase(ctx, r.client, plan.ServerID.ValueInt32(), apiCreateServerDatabase{
+		Database: plan.Name.ValueString(),
+		Remote:   plan.Remote.ValueString(),
+		Host:     plan.HostID.ValueInt32(),
+	})
+	if err != nil {
+		addAPIError(&resp.Diagnostics, serverDatabaseAPIFields, err,
+			"Error creating server database",
+			contextErrorDetail(ctx, "creating the server database", "Could not create server database, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	// The password is only returned when reading the database
+	database, err := getServerDatabase(ctx, r.client, plan.ServerID.ValueInt32(), databaseID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pterodactyl Server Database",
+			contextErrorDetail(ctx, "reading the server database", "Could not read created Pterodactyl server database ID "+strconv.FormatInt(int64(databaseID), 10)+": "+err.Error()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.fromAPI(database)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *serverDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state serverDatabaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed database value from Pterodactyl
+	database, err := getServerDatabase(ctx, r.client, state.ServerID.ValueInt32(), state.ID.ValueInt32())
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Pterodactyl server database not found, removing it from state", map[string]interface{}{
+			"server_id": state.ServerID.ValueInt32(),
+			"id":        state.ID.ValueInt32(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pterodactyl Server Database",
+			contextErrorDetail(ctx, "reading the server database", "Could not read Pterodactyl server database ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error()),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.fromAPI(database)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resets the password of the database, every other attribute
+// requires a replacement.
+func (r *serverDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state serverDatabaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if !plan.RotatePasswordTrigger.Equal(state.RotatePasswordTrigger) {
+		err := resetServerDatabasePassword(ctx, r.client, plan.ServerID.ValueInt32(), plan.ID.ValueInt32())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Pterodactyl Server Database",
+				contextErrorDetail(ctx, "resetting the server database password", "Could not reset server database password, unexpected error: "+err.Error()),
+			)
+			return
+		}
+	}
+
+	database, err := getServerDatabase(ctx, r.client, plan.ServerID.ValueInt32(), plan.ID.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pterodactyl Server Database",
+			contextErrorDetail(ctx, "reading the server database", "Could not read Pterodactyl server database ID "+strconv.FormatInt(int64(plan.ID.ValueInt32()), 10)+": "+err.Error()),
+		)
+		return
+	}
+
+	// Update resource state with updated values
+	plan.fromAPI(database)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serverDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state serverDatabaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing database
+	err := deleteServerDatabase(ctx, r.client, state.ServerID.ValueInt32(), state.ID.ValueInt32())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Pterodactyl Server Database",
+			contextErrorDetail(ctx, "deleting the server database", "Could not delete server database, unexpected error: "+err.Error()),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serverDatabaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Application
+}
+
+// ImportState imports a database by the ID of its server and its own ID,
+// separated by a slash.
+func (r *serverDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverPart, databasePart, ok := strings.Cut(req.ID, "/")
+	serverID, serverErr := strconv.ParseInt(serverPart, 10, 32)
+	databaseID, databaseErr := strconv.ParseInt(databasePart, 10, 32)
+	if !ok || serverErr != nil || databaseErr != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Pterodactyl Server Database",
+			"Expected an import ID like <server_id>/<database_id>, got: "+req.ID,
+		)
+		return
+	}
+
+	database, err := getServerDatabase(ctx, r.client, int32(serverID), int32(databaseID))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Pterodactyl Server Database",
+			"Could not import server database: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	var state serverDatabaseResourceModel
+	state.fromAPI(database)
+
+	state.Timeouts = importTimeouts(ctx, resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// fromAPI maps a database returned by the panel, including its password and
+// host, onto the model.
+func (m *serverDatabaseResourceModel) fromAPI(database apiServerDatabase) {
+	host := database.Relationships.Host.Attributes
+
+	m.ID = types.Int32Value(database.ID)
+	m.ServerID = types.Int32Value(database.Server)
+	m.HostID = types.Int32Value(database.Host)
+	m.Name = types.StringValue(strings.TrimPrefix(database.Database, fmt.Sprintf("s%d_", database.Server)))
+	m.Remote = types.StringValue(database.Remote)
+	m.Database = types.StringValue(database.Database)
+	m.Username = types.StringValue(database.Username)
+	m.Password = types.StringValue(database.Relationships.Password.Attributes.Password)
+	m.JDBCURL = types.StringValue("jdbc:mysql://" + net.JoinHostPort(host.Host, strconv.Itoa(int(host.Port))) + "/" + database.Database)
+	m.MaxConnections = types.Int32PointerValue(database.MaxConnections)
+	m.CreatedAt = types.StringValue(database.CreatedAt.Format(time.RFC3339))
+	m.UpdatedAt = types.StringValue(database.UpdatedAt.Format(time.RFC3339))
+}
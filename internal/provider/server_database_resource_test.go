@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServerDatabaseResourceRead(t *testing.T) {
+	state := replayResourceRead(t, NewServerDatabaseResource(), "server_database_resource_read", map[string]tftypes.Value{
+		"id":                      tftypes.NewValue(tftypes.Number, 3),
+		"server_id":               tftypes.NewValue(tftypes.Number, 9),
+		"password":                tftypes.NewValue(tftypes.String, "rotated-elsewhere"),
+		"rotate_password_trigger": tftypes.NewValue(tftypes.String, "2024-05"),
+	})
+
+	var database serverDatabaseResourceModel
+	replayStateGet(t, state, &database)
+
+	replayCheck(t, "id", database.ID, types.Int32Value(3))
+	replayCheck(t, "server_id", database.ServerID, types.Int32Value(9))
+	replayCheck(t, "host_id", database.HostID, types.Int32Value(1))
+	replayCheck(t, "name", database.Name, types.StringValue("app"))
+	replayCheck(t, "remote", database.Remote, types.StringValue("%"))
+	replayCheck(t, "database", database.Database, types.StringValue("s9_app"))
+	replayCheck(t, "username", database.Username, types.StringValue("u9_Xk3pQ7rT2w"))
+	replayCheck(t, "password", database.Password, types.StringValue("q8Zt!vN3@pL6xR1e"))
+	replayCheck(t, "jdbc_url", database.JDBCURL, types.StringValue("jdbc:mysql://10.0.0.20:3306/s9_app"))
+	replayCheck(t, "max_connections", database.MaxConnections, types.Int32Value(0))
+	replayCheck(t, "rotate_password_trigger", database.RotatePasswordTrigger, types.StringValue("2024-05"))
+	replayCheck(t, "created_at", database.CreatedAt, types.StringValue("2024-04-21T08:00:00Z"))
+	replayCheck(t, "updated_at", database.UpdatedAt, types.StringValue("2024-05-02T10:15:00Z"))
+}
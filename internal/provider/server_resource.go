@@ -0,0 +1,698 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serverResource{}
+	_ resource.ResourceWithConfigure   = &serverResource{}
+	_ resource.ResourceWithImportState = &serverResource{}
+)
+
+// NewServerResource is a helper function to simplify the provider implementation.
+func NewServerResource() resource.Resource {
+	return &serverResource{}
+}
+
+// serverResource is the resource implementation.
+type serverResource struct {
+	client *pterodactyl.Client
+}
+
+// serverResourceModel maps the resource schema data.
+type serverResourceModel struct {
+	ID            types.Int32              `tfsdk:"id"`
+	UUID          types.String             `tfsdk:"uuid"`
+	Identifier    types.String             `tfsdk:"identifier"`
+	ExternalID    types.String             `tfsdk:"external_id"`
+	Name          types.String             `tfsdk:"name"`
+	Description   types.String             `tfsdk:"description"`
+	UserID        types.Int32              `tfsdk:"user_id"`
+	NodeID        types.Int32              `tfsdk:"node_id"`
+	AllocationID  types.Int32              `tfsdk:"allocation_id"`
+	NestID        types.Int32              `tfsdk:"nest_id"`
+	EggID         types.Int32              `tfsdk:"egg_id"`
+	DockerImage   types.String             `tfsdk:"docker_image"`
+	Startup       types.String             `tfsdk:"startup"`
+	Environment   map[string]types.String  `tfsdk:"environment"`
+	Limits        serverLimitsModel        `tfsdk:"limits"`
+	FeatureLimits serverFeatureLimitsModel `tfsdk:"feature_limits"`
+	CreatedAt     types.String             `tfsdk:"created_at"`
+	UpdatedAt     types.String             `tfsdk:"updated_at"`
+	Timeouts      timeouts.Value           `tfsdk:"timeouts"`
+}
+
+// serverLimitsModel maps the resource limits of a server.
+type serverLimitsModel struct {
+	Memory  types.Int32  `tfsdk:"memory"`
+	Swap    types.Int32  `tfsdk:"swap"`
+	Disk    types.Int32  `tfsdk:"disk"`
+	IO      types.Int32  `tfsdk:"io"`
+	CPU     types.Int32  `tfsdk:"cpu"`
+	Threads types.String `tfsdk:"threads"`
+}
+
+// serverFeatureLimitsModel maps the feature limits of a server.
+type serverFeatureLimitsModel struct {
+	Databases   types.Int32 `tfsdk:"databases"`
+	Allocations types.Int32 `tfsdk:"allocations"`
+	Backups     types.Int32 `tfsdk:"backups"`
+}
+
+// serverPanelEnvironment lists the environment variables the panel injects
+// into every server, which are never part of the configuration.
+var serverPanelEnvironment = []string{
+	"STARTUP",
+	"P_SERVER_LOCATION",
+	"P_SERVER_UUID",
+	"P_SERVER_ALLOCATION_LIMIT",
+}
+
+// serverAPIFields maps the fields of panel validation errors to the server
+// schema. The build endpoint validates limits both nested and at the root.
+var serverAPIFields = apiFieldPaths{
+	"name":                       path.Root("name"),
+	"description":                path.Root("description"),
+	"external_id":                path.Root("external_id"),
+	"user":                       path.Root("user_id"),
+	"egg":                        path.Root("egg_id"),
+	"docker_image":               path.Root("docker_image"),
+	"image":                      path.Root("docker_image"),
+	"startup":                    path.Root("startup"),
+	"environment":                path.Root("environment"),
+	"allocation":                 path.Root("allocation_id"),
+	"limits":                     path.Root("limits"),
+	"limits.memory":              path.Root("limits").AtName("memory"),
+	"limits.swap":                path.Root("limits").AtName("swap"),
+	"limits.disk":                path.Root("limits").AtName("disk"),
+	"limits.io":                  path.Root("limits").AtName("io"),
+	"limits.cpu":                 path.Root("limits").AtName("cpu"),
+	"limits.threads":             path.Root("limits").AtName("threads"),
+	"memory":                     path.Root("limits").AtName("memory"),
+	"swap":                       path.Root("limits").AtName("swap"),
+	"disk":                       path.Root("limits").AtName("disk"),
+	"io":                         path.Root("limits").AtName("io"),
+	"cpu":                        path.Root("limits").AtName("cpu"),
+	"threads":                    path.Root("limits").AtName("threads"),
+	"feature_limits":             path.Root("feature_limits"),
+	"feature_limits.databases":   path.Root("feature_limits").AtName("databases"),
+	"feature_limits.allocations": path.Root("feature_limits").AtName("allocations"),
+	"feature_limits.backups":     path.Root("feature_limits").AtName("backups"),
+	"database_limit":             path.Root("feature_limits").AtName("databases"),
+	"allocation_limit":           path.Root("feature_limits").AtName("allocations"),
+	"backup_limit":               path.Root("feature_limits").AtName("backups"),
+}
+
+// serverImportIDs are the import IDs of servers.
+var serverImportIDs = importIDLookup{
+	Object:     "server",
+	Endpoint:   "/api/application/servers",
+	DefaultKey: "id",
+	Keys:       []string{"uuid", "name", "external_id"},
+}
+
+// Metadata returns the resource type name.
+func (r *serverResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+// Schema defines the schema for the resource.
+func (r *serverResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Pterodactyl server resource allows Terraform to manage servers in the Pterodactyl Panel API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				Description: "The ID of the server.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "The UUID of the server.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				Description: "The short identifier of the server.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Description: "The external ID of the server.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the server.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the server.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"user_id": schema.Int32Attribute{
+				Description: "The ID of the user owning the server.",
+				Required:    true,
+			},
+			"node_id": schema.Int32Attribute{
+				Description: "The ID of the node the server is running on.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"allocation_id": schema.Int32Attribute{
+				Description: "The ID of the primary allocation of the server. Changing it assigns the new allocation to the server and takes the previous one from it.",
+				Required:    true,
+			},
+			"nest_id": schema.Int32Attribute{
+				Description: "The ID of the nest of the server's egg.",
+				Computed:    true,
+			},
+			"egg_id": schema.Int32Attribute{
+				Description: "The ID of the egg of the server.",
+				Required:    true,
+			},
+			"docker_image": schema.StringAttribute{
+				Description: "The docker image of the server.",
+				Required:    true,
+			},
+			"startup": schema.StringAttribute{
+				Description: "The startup command of the server.",
+				Required:    true,
+			},
+			"environment": schema.MapAttribute{
+				Description: "The environment variables of the server.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"limits": schema.SingleNestedAttribute{
+				Description: "The resource limits of the server.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"memory": schema.Int32Attribute{
+						Description: "The memory limit of the server in MiB.",
+						Required:    true,
+					},
+					"swap": schema.Int32Attribute{
+						Description: "The swap limit of the server in MiB.",
+						Required:    true,
+					},
+					"disk": schema.Int32Attribute{
+						Description: "The disk limit of the server in MiB.",
+						Required:    true,
+					},
+					"io": schema.Int32Attribute{
+						Description: "The IO weight of the server.",
+						Required:    true,
+					},
+					"cpu": schema.Int32Attribute{
+						Description: "The CPU limit of the server in percent.",
+						Required:    true,
+					},
+					"threads": schema.StringAttribute{
+						Description: "The CPU threads the server is pinned to.",
+						Optional:    true,
+					},
+				},
+			},
+			"feature_limits": schema.SingleNestedAttribute{
+				Description: "The feature limits of the server.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"databases": schema.Int32Attribute{
+						Description: "The maximum amount of databases of the server.",
+						Required:    true,
+					},
+					"allocations": schema.Int32Attribute{
+						Description: "The maximum amount of allocations of the server.",
+						Required:    true,
+					},
+					"backups": schema.Int32Attribute{
+						Description: "The maximum amount of backups of the server.",
+						Required:    true,
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The creation date of the server.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The last update date of the server.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan serverResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create partial server
+	partialServer := apiCreateServer{
+		Name:          plan.Name.ValueString(),
+		Description:   plan.Description.ValueString(),
+		ExternalID:    plan.ExternalID.ValueStringPointer(),
+		User:          plan.UserID.ValueInt32(),
+		Egg:           plan.EggID.ValueInt32(),
+		DockerImage:   plan.DockerImage.ValueString(),
+		Startup:       plan.Startup.ValueString(),
+		Environment:   plan.environment(),
+		Limits:        plan.Limits.toAPI(),
+		FeatureLimits: plan.FeatureLimits.toAPI(),
+		Allocation: apiServerAllocation{
+			Default: plan.AllocationID.ValueInt32(),
+		},
+	}
+
+	// Create new server
+	server, err := createServer(ctx, r.client, partialServer)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, serverAPIFields, err,
+			"Error creating server",
+			contextErrorDetail(ctx, "creating the server", "Could not create server, unexpected error: "+err.Error()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.fromAPI(server, false)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state serverResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed server value from Pterodactyl
+	server, err := getServer(ctx, r.client, state.ID.ValueInt32())
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Pterodactyl server not found, removing it from state", map[string]interface{}{
+			"id": state.ID.ValueInt32(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pterodactyl Server",
+			contextErrorDetail(ctx, "reading the server", "Could not read Pterodactyl server ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error()),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.fromAPI(server, false)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state serverResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	serverID := state.ID.ValueInt32()
+
+	// The panel splits server updates over separate endpoints, only call
+	// the ones whose attributes actually changed.
+	var server apiServer
+	var err error
+	updated := false
+
+	if !plan.Name.Equal(state.Name) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.ExternalID.Equal(state.ExternalID) ||
+		!plan.UserID.Equal(state.UserID) {
+		server, err = updateServerDetails(ctx, r.client, serverID, apiServerDetails{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+			ExternalID:  plan.ExternalID.ValueStringPointer(),
+			User:        plan.UserID.ValueInt32(),
+		})
+		if err != nil {
+			addAPIError(&resp.Diagnostics, serverAPIFields, err,
+				"Error Updating Pterodactyl Server Details",
+				contextErrorDetail(ctx, "updating the server details", "Could not update server details, unexpected error: "+err.Error()),
+			)
+			return
+		}
+		updated = true
+	}
+
+	if !plan.AllocationID.Equal(state.AllocationID) ||
+		plan.Limits != state.Limits ||
+		plan.FeatureLimits != state.FeatureLimits {
+		// The build endpoint turns the OOM killer back on unless oom_disabled
+		// is sent, keep the setting of the panel
+		var current apiServer
+		current, err = getServer(ctx, r.client, serverID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Pterodactyl Server Build",
+				contextErrorDetail(ctx, "reading the server", "Could not read Pterodactyl server ID "+strconv.FormatInt(int64(serverID), 10)+": "+err.Error()),
+			)
+			return
+		}
+
+		build := apiServerBuild{
+			Allocation:    plan.AllocationID.ValueInt32(),
+			OOMDisabled:   current.Limits.OOMDisabled,
+			Limits:        plan.Limits.toAPI(),
+			FeatureLimits: plan.FeatureLimits.toAPI(),
+		}
+		build.Limits.OOMDisabled = current.Limits.OOMDisabled
+
+		// The panel only accepts a default allocation assigned to the server,
+		// so the new one is assigned and the previous one taken from it
+		if current.Allocation != build.Allocation {
+			build.AddAllocations = []int32{build.Allocation}
+			build.RemoveAllocations = []int32{current.Allocation}
+		}
+
+		server, err = updateServerBuild(ctx, r.client, serverID, build)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, serverAPIFields, err,
+				"Error Updating Pterodactyl Server Build",
+				contextErrorDetail(ctx, "updating the server build configuration", "Could not update server build configuration, unexpected error: "+err.Error()),
+			)
+			return
+		}
+		updated = true
+	}
+
+	if !plan.Startup.Equal(state.Startup) ||
+		!plan.DockerImage.Equal(state.DockerImage) ||
+		!plan.EggID.Equal(state.EggID) ||
+		!environmentEqual(plan.Environment, state.Environment) {
+		server, err = updateServerStartup(ctx, r.client, serverID, apiServerStartup{
+			Startup:     plan.Startup.ValueString(),
+			Environment: plan.environment(),
+			Egg:         plan.EggID.ValueInt32(),
+			Image:       plan.DockerImage.ValueString(),
+		})
+		if err != nil {
+			addAPIError(&resp.Diagnostics, serverAPIFields, err,
+				"Error Updating Pterodactyl Server Startup",
+				contextErrorDetail(ctx, "updating the server startup configuration", "Could not update server startup configuration, unexpected error: "+err.Error()),
+			)
+			return
+		}
+		updated = true
+	}
+
+	if !updated {
+		server, err = getServer(ctx, r.client, serverID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Pterodactyl Server",
+				contextErrorDetail(ctx, "reading the server", "Could not read Pterodactyl server ID "+strconv.FormatInt(int64(serverID), 10)+": "+err.Error()),
+			)
+			return
+		}
+	}
+
+	// Update resource state with updated values
+	plan.fromAPI(server, false)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state serverResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing server
+	err := deleteServer(ctx, r.client, state.ID.ValueInt32())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Pterodactyl Server",
+			contextErrorDetail(ctx, "deleting the server", "Could not delete server, unexpected error: "+err.Error()),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serverResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*pterodactylClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Application
+}
+
+func (r *serverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverID, ok := serverImportIDs.resolve(ctx, r.client, &resp.Diagnostics, req.ID)
+	if !ok {
+		return
+	}
+
+	server, err := getServer(ctx, r.client, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Pterodactyl Server",
+			"Could not import server: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	var state serverResourceModel
+	state.fromAPI(server, true)
+
+	state.Timeouts = importTimeouts(ctx, resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// fromAPI maps a server returned by the panel onto the model. The panel
+// returns every egg variable plus a few injected ones, so unless all of them
+// are requested only the variables already present in the model are kept.
+func (m *serverResourceModel) fromAPI(server apiServer, allEnvironment bool) {
+	m.ID = types.Int32Value(server.ID)
+	m.UUID = types.StringValue(server.UUID)
+	m.Identifier = types.StringValue(server.Identifier)
+	m.ExternalID = types.StringPointerValue(server.ExternalID)
+	m.Name = types.StringValue(server.Name)
+	m.Description = types.StringValue(server.Description)
+	m.UserID = types.Int32Value(server.User)
+	m.NodeID = types.Int32Value(server.Node)
+	m.AllocationID = types.Int32Value(server.Allocation)
+	m.NestID = types.Int32Value(server.Nest)
+	m.EggID = types.Int32Value(server.Egg)
+	m.DockerImage = types.StringValue(server.Container.Image)
+	m.Startup = types.StringValue(server.Container.StartupCommand)
+	m.Limits = serverLimitsModel{
+		Memory:  types.Int32Value(server.Limits.Memory),
+		Swap:    types.Int32Value(server.Limits.Swap),
+		Disk:    types.Int32Value(server.Limits.Disk),
+		IO:      types.Int32Value(server.Limits.IO),
+		CPU:     types.Int32Value(server.Limits.CPU),
+		Threads: types.StringPointerValue(server.Limits.Threads),
+	}
+	m.FeatureLimits = serverFeatureLimitsModel{
+		Databases:   types.Int32Value(server.FeatureLimits.Databases),
+		Allocations: types.Int32Value(server.FeatureLimits.Allocations),
+		Backups:     types.Int32Value(server.FeatureLimits.Backups),
+	}
+	m.CreatedAt = types.StringValue(server.CreatedAt.Format(time.RFC3339))
+	m.UpdatedAt = types.StringValue(server.UpdatedAt.Format(time.RFC3339))
+
+	if allEnvironment {
+		m.Environment = make(map[string]types.String, len(server.Container.Environment))
+	environment:
+		for key, value := range server.Container.Environment {
+			for _, injected := range serverPanelEnvironment {
+				if strings.EqualFold(key, injected) {
+					continue environment
+				}
+			}
+			m.Environment[key] = types.StringValue(value)
+		}
+		return
+	}
+
+	for key := range m.Environment {
+		if value, ok := server.Container.Environment[key]; ok {
+			m.Environment[key] = types.StringValue(value)
+		} else {
+			delete(m.Environment, key)
+		}
+	}
+}
+
+// environment returns the configured environment variables as plain strings.
+func (m serverResourceModel) environment() map[string]string {
+	environment := make(map[string]string, len(m.Environment))
+	for key, value := range m.Environment {
+		environment[key] = value.ValueString()
+	}
+	return environment
+}
+
+func (l serverLimitsModel) toAPI() apiServerLimits {
+	return apiServerLimits{
+		Memory:  l.Memory.ValueInt32(),
+		Swap:    l.Swap.ValueInt32(),
+		Disk:    l.Disk.ValueInt32(),
+		IO:      l.IO.ValueInt32(),
+		CPU:     l.CPU.ValueInt32(),
+		Threads: l.Threads.ValueStringPointer(),
+	}
+}
+
+func (l serverFeatureLimitsModel) toAPI() apiServerFeatureLimits {
+	return apiServerFeatureLimits{
+		Databases:   l.Databases.ValueInt32(),
+		Allocations: l.Allocations.ValueInt32(),
+		Backups:     l.Backups.ValueInt32(),
+	}
+}
+
+// environmentEqual reports whether two environment maps hold the same values.
+func environmentEqual(a, b map[string]types.String) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		other, ok := b[key]
+		if !ok || !value.Equal(other) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccServerResource(t *testing.T) {
+	panel := newFakePanel(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             panel.testAccCheckDestroyed,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(panel) + testAccServerResourceConfig("25565", 1024),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("pterodactyl_server.test", "allocation_id", "pterodactyl_allocation.test", "allocation_ids.25565"),
+					resource.TestCheckResourceAttrPair("pterodactyl_server.test", "node_id", "pterodactyl_node.test", "id"),
+					resource.TestCheckResourceAttr("pterodactyl_server.test", "limits.memory", "1024"),
+					testAccCheckServerAllocations(panel, "pterodactyl_server.test", "25565"),
+					// Disable the OOM killer in the panel, which the build
+					// update below must keep
+					testAccSetServerOOMDisabled(panel, "pterodactyl_server.test"),
+				),
+			},
+			// Update and Read testing, switching to an unassigned allocation
+			{
+				Config: testAccProviderConfig(panel) + testAccServerResourceConfig("25566", 2048),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("pterodactyl_server.test", "allocation_id", "pterodactyl_allocation.test", "allocation_ids.25566"),
+					resource.TestCheckResourceAttr("pterodactyl_server.test", "limits.memory", "2048"),
+					testAccCheckServerAllocations(panel, "pterodactyl_server.test", "25566"),
+					testAccCheckServerOOMDisabled(panel, "pterodactyl_server.test"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccServerResourceConfig(port string, memory int) string {
+	return testAccAllocationResourceConfig("25565-25566") + fmt.Sprintf(`
+resource "pterodactyl_user" "test" {
+  username   = "owner"
+  email      = "owner@example.com"
+  first_name = "Server"
+  last_name  = "Owner"
+}
+
+resource "pterodactyl_server" "test" {
+  name          = "survival"
+  user_id       = pterodactyl_user.test.id
+  allocation_id = pterodactyl_allocation.test.allocation_ids[%q]
+  egg_id        = 3
+  docker_image  = "ghcr.io/pterodactyl/yolks:java_21"
+  startup       = "java -jar {{SERVER_JARFILE}}"
+
+  limits = {
+    memory = %d
+    swap   = 0
+    disk   = 10240
+    io     = 500
+    cpu    = 200
+  }
+
+  feature_limits = {
+    databases   = 0
+    allocations = 1
+    backups     = 0
+  }
+}
+`, port, memory)
+}
+
+// testAccFakeServer returns the server of resourceName in the fake panel,
+// the caller must hold the lock of the panel.
+func testAccFakeServer(p *fakePanel, s *terraform.State, resourceName string) (*apiServer, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource %s not found in state", resourceName)
+	}
+
+	id, err := strconv.ParseInt(rs.Primary.ID, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("resource %s has a non-numeric ID %q", resourceName, rs.Primary.ID)
+	}
+
+	server, ok := p.servers[int32(id)]
+	if !ok {
+		return nil, fmt.Errorf("server %d of %s not found in the panel", id, resourceName)
+	}
+
+	return server, nil
+}
+
+// testAccCheckServerAllocations checks the ports of the allocations assigned
+// to the server of resourceName in the fake panel.
+func testAccCheckServerAllocations(p *fakePanel, resourceName string, ports ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		server, err := testAccFakeServer(p, s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		var assigned []string
+		for _, id := range sortedKeys(p.allocations) {
+			if p.assignedTo(p.allocations[id], server) {
+				assigned = append(assigned, strconv.Itoa(int(p.allocations[id].Port)))
+			}
+		}
+
+		if fmt.Sprint(assigned) != fmt.Sprint(ports) {
+			return fmt.Errorf("server %d has the allocations %v assigned, expected %v", server.ID, assigned, ports)
+		}
+		return nil
+	}
+}
+
+// testAccSetServerOOMDisabled disables the OOM killer of the server of
+// resourceName behind the back of Terraform.
+func testAccSetServerOOMDisabled(p *fakePanel, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		server, err := testAccFakeServer(p, s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		server.Limits.OOMDisabled = true
+		return nil
+	}
+}
+
+func testAccCheckServerOOMDisabled(p *fakePanel, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		server, err := testAccFakeServer(p, s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !server.Limits.OOMDisabled {
+			return fmt.Errorf("server %d has the OOM killer enabled again", server.ID)
+		}
+		return nil
+	}
+}
+
+func TestServerResourceRead(t *testing.T) {
+	r := NewServerResource()
+	stateType := replayResourceType(t, r)
+	environmentType := stateType.AttributeTypes["environment"]
+
+	state := replayResourceRead(t, r, "server_resource_read", map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.Number, 9),
+		"environment": tftypes.NewValue(environmentType, map[string]tftypes.Value{
+			"SERVER_JARFILE": tftypes.NewValue(tftypes.String, "paper.jar"),
+			"BUILD_NUMBER":   tftypes.NewValue(tftypes.String, "latest"),
+			// Removed from the egg, it is dropped from the state.
+			"MINECRAFT_VERSION": tftypes.NewValue(tftypes.String, "1.20.4"),
+		}),
+		"limits":         replayObject(t, stateType.AttributeTypes["limits"], nil),
+		"feature_limits": replayObject(t, stateType.AttributeTypes["feature_limits"], nil),
+	})
+
+	var server serverResourceModel
+	replayStateGet(t, state, &server)
+
+	replayCheck(t, "id", server.ID, types.Int32Value(9))
+	replayCheck(t, "identifier", server.Identifier, types.StringValue("c3d4e5f6"))
+	replayCheck(t, "external_id", server.ExternalID, types.StringNull())
+	replayCheck(t, "name", server.Name, types.StringValue("survival"))
+	replayCheck(t, "user_id", server.UserID, types.Int32Value(7))
+	replayCheck(t, "node_id", server.NodeID, types.Int32Value(2))
+	replayCheck(t, "allocation_id", server.AllocationID, types.Int32Value(11))
+	replayCheck(t, "egg_id", server.EggID, types.Int32Value(3))
+	replayCheck(t, "docker_image", server.DockerImage, types.StringValue("ghcr.io/pterodactyl/yolks:java_21"))
+	replayCheck(t, "limits.memory", server.Limits.Memory, types.Int32Value(4096))
+	replayCheck(t, "limits.cpu", server.Limits.CPU, types.Int32Value(200))
+	replayCheck(t, "limits.threads", server.Limits.Threads, types.StringNull())
+	replayCheck(t, "feature_limits.allocations", server.FeatureLimits.Allocations, types.Int32Value(2))
+	replayCheck(t, "feature_limits.backups", server.FeatureLimits.Backups, types.Int32Value(3))
+	replayCheck(t, "created_at", server.CreatedAt, types.StringValue("2024-04-19T11:22:33Z"))
+	replayCheck(t, "updated_at", server.UpdatedAt, types.StringValue("2024-04-20T16:00:00Z"))
+
+	if len(server.Environment) != 2 {
+		t.Errorf("expected 2 environment variables, got %v", server.Environment)
+	}
+	replayCheck(t, "environment.SERVER_JARFILE", server.Environment["SERVER_JARFILE"], types.StringValue("server.jar"))
+	// Unset variables are returned as null by the panel.
+	replayCheck(t, "environment.BUILD_NUMBER", server.Environment["BUILD_NUMBER"], types.StringValue(""))
+}
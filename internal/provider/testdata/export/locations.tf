@@ -0,0 +1,19 @@
+import {
+  to = pterodactyl_location.de_fra
+  id = "3"
+}
+
+resource "pterodactyl_location" "de_fra" {
+  short = "de-fra"
+  long  = "Frankfurt, Germany"
+}
+
+import {
+  to = pterodactyl_location.us_nyc
+  id = "4"
+}
+
+resource "pterodactyl_location" "us_nyc" {
+  short = "us-nyc"
+  long  = "New York, USA"
+}
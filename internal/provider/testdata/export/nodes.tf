@@ -0,0 +1,58 @@
+import {
+  to = pterodactyl_node.fra_1
+  id = "2"
+}
+
+resource "pterodactyl_node" "fra_1" {
+  name                = "fra-1"
+  description         = "Game servers in Frankfurt"
+  location_id         = pterodactyl_location.de_fra.id
+  public              = true
+  behind_proxy        = false
+  maintenance_mode    = false
+  fqdn                = "fra-1.example.com"
+  scheme              = "https"
+  memory              = 32768
+  memory_overallocate = 0
+  disk                = 512000
+  disk_overallocate   = -1
+  upload_size         = 100
+  daemon_sftp         = 2022
+  daemon_listen       = 8080
+  allocations = [
+    {
+      ip    = "10.0.0.1"
+      port  = 25565
+      alias = "play.example.com"
+      notes = "Survival"
+    },
+    {
+      ip   = "10.0.0.1"
+      port = 25566
+    },
+  ]
+}
+
+import {
+  to = pterodactyl_node.nyc_1
+  id = "5"
+}
+
+resource "pterodactyl_node" "nyc_1" {
+  name                = "nyc-1"
+  description         = ""
+  location_id         = pterodactyl_location.us_nyc.id
+  public              = false
+  behind_proxy        = false
+  maintenance_mode    = true
+  fqdn                = "nyc-1.example.com"
+  scheme              = "https"
+  memory              = 32768
+  memory_overallocate = 0
+  disk                = 512000
+  disk_overallocate   = -1
+  upload_size         = 100
+  daemon_sftp         = 2022
+  daemon_listen       = 8080
+  allocations         = []
+}
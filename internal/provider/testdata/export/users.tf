@@ -0,0 +1,28 @@
+import {
+  to = pterodactyl_user.terraformer
+  id = "id:7"
+}
+
+resource "pterodactyl_user" "terraformer" {
+  username   = "terraformer"
+  email      = "terraformer@example.com"
+  first_name = "Terra"
+  last_name  = "Former"
+  root_admin = true
+  language   = "en"
+}
+
+import {
+  to = pterodactyl_user.user_1337_gamer
+  id = "id:8"
+}
+
+resource "pterodactyl_user" "user_1337_gamer" {
+  username    = "1337.gamer"
+  email       = "gamer@example.com"
+  first_name  = "Leet"
+  last_name   = "Gamer"
+  root_admin  = false
+  language    = "de"
+  external_id = "sso-1042"
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Default timeouts of the resources, used when the timeouts block does not
+// set them. Creating and updating nodes may create dozens of allocations one
+// request at a time, so those get the most time.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
+
+// contextErrorDetail returns detail prefixed with the step of the operation
+// that was running when ctx timed out or was cancelled, and detail unchanged
+// otherwise.
+func contextErrorDetail(ctx context.Context, step, detail string) string {
+	switch err := ctx.Err(); {
+	case errors.Is(err, context.DeadlineExceeded):
+		return "The operation timed out while " + step + ". " +
+			"If the Pterodactyl Panel needs more time, increase the value in the timeouts block of the resource.\n\n" + detail
+	case errors.Is(err, context.Canceled):
+		return "The operation was cancelled while " + step + ".\n\n" + detail
+	}
+
+	return detail
+}
+
+// importTimeouts returns the empty timeouts block of a resource being
+// imported, the block is not part of the panel data.
+func importTimeouts(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) timeouts.Value {
+	var value timeouts.Value
+	diags.Append(state.GetAttribute(ctx, path.Root("timeouts"), &value)...)
+	return value
+}
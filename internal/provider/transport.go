@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultMaxRetries is the amount of times a request is retried when the
+	// provider configuration does not set max_retries.
+	defaultMaxRetries = 3
+	// defaultRetryMinWait is the initial backoff between two attempts.
+	defaultRetryMinWait = 1 * time.Second
+	// defaultRetryMaxWait caps the backoff between two attempts.
+	defaultRetryMaxWait = 30 * time.Second
+	// defaultRequestTimeout bounds a single attempt, matching the timeout
+	// of the HTTP client created by pterodactyl-client-go.
+	defaultRequestTimeout = 10 * time.Second
+)
+
+// newHTTPTransport returns a copy of http.DefaultTransport using tlsConfig.
+func newHTTPTransport(tlsConfig *tls.Config) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return transport
+}
+
+// retryTransport is a http.RoundTripper retrying requests that failed with a
+// transient error, backing off exponentially or as long as the panel asks to.
+type retryTransport struct {
+	next           http.RoundTripper
+	maxRetries     int
+	minWait        time.Duration
+	maxWait        time.Duration
+	attemptTimeout time.Duration
+}
+
+// newRetryTransport wraps next with retries, each attempt bounded by
+// attemptTimeout, falling back to http.DefaultTransport when next is nil.
+func newRetryTransport(next http.RoundTripper, maxRetries int, minWait, maxWait, attemptTimeout time.Duration) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &retryTransport{
+		next:           next,
+		maxRetries:     maxRetries,
+		minWait:        minWait,
+		maxWait:        maxWait,
+		attemptTimeout: attemptTimeout,
+	}
+}
+
+// RoundTrip executes a single HTTP transaction, retrying it on transient errors.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		attemptReq, cancel, err := t.prepareAttempt(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := t.next.RoundTrip(attemptReq)
+
+		if attempt >= t.maxRetries || !shouldRetry(req, res, err) {
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			// Keep the attempt context alive until the body has been read.
+			res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+			return res, nil
+		}
+
+		wait := t.backoff(attempt, res)
+
+		if err != nil {
+			tflog.Debug(ctx, "Retrying Pterodactyl API request after error", map[string]interface{}{
+				"method":  req.Method,
+				"url":     req.URL.String(),
+				"attempt": attempt + 1,
+				"wait":    wait.String(),
+				"error":   err.Error(),
+			})
+		} else {
+			tflog.Debug(ctx, "Retrying Pterodactyl API request after transient status", map[string]interface{}{
+				"method":  req.Method,
+				"url":     req.URL.String(),
+				"attempt": attempt + 1,
+				"wait":    wait.String(),
+				"status":  res.StatusCode,
+			})
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+		cancel()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// prepareAttempt clones the request for a single attempt, rewinding its body
+// and bounding it with the per-attempt timeout.
+func (t *retryTransport) prepareAttempt(req *http.Request, attempt int) (*http.Request, context.CancelFunc, error) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if t.attemptTimeout > 0 {
+		ctx, cancel = context.WithTimeout(req.Context(), t.attemptTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(req.Context())
+	}
+
+	attemptReq := req.Clone(ctx)
+	if attempt > 0 && req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+		attemptReq.Body = body
+	}
+
+	return attemptReq, cancel, nil
+}
+
+// backoff returns how long to wait before the next attempt. Waits requested
+// by the panel through Retry-After or X-RateLimit-Reset take precedence over
+// the exponential backoff, both are capped by the maximum wait.
+func (t *retryTransport) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := retryAfter(res); ok {
+			return min(max(wait, t.minWait), t.maxWait)
+		}
+	}
+
+	wait := t.minWait
+	for i := 0; i < attempt && wait < t.maxWait; i++ {
+		wait *= 2
+	}
+
+	return min(wait, t.maxWait)
+}
+
+// shouldRetry reports whether a request is worth retrying. Rate limiting is
+// retried for every request, the panel rejects those before processing them.
+// Other transient errors come from proxies as well, which may have passed the
+// request on already, so they are only retried for idempotent requests to
+// avoid creating an object twice.
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if req.Body != nil && req.GetBody == nil {
+		// The body cannot be replayed.
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// retryAfter parses the wait requested by the panel, either through the
+// Retry-After header (seconds or HTTP date) or, once the rate limit is
+// exhausted, the X-RateLimit-Reset unix timestamp.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if value := res.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return time.Until(date), true
+		}
+	}
+
+	if res.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			return time.Until(time.Unix(reset, 0)), true
+		}
+	}
+
+	return 0, false
+}
+
+// cancelOnCloseBody releases the context of an attempt once its response
+// body has been closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// defaultRequestsPerMinute matches the default rate limit of the
+// application API of the panel.
+const defaultRequestsPerMinute = 240
+
+// rateLimitTransport is a http.RoundTripper delaying requests so that all
+// resources and data sources sharing the client stay within the rate limit
+// of the panel.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *tokenBucket
+}
+
+// newRateLimitTransport wraps next with a limit of requestsPerMinute,
+// falling back to http.DefaultTransport when next is nil.
+func newRateLimitTransport(next http.RoundTripper, requestsPerMinute int) *rateLimitTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &rateLimitTransport{
+		next:    next,
+		limiter: newTokenBucket(requestsPerMinute),
+	}
+}
+
+// RoundTrip executes a single HTTP transaction once the rate limit allows it.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// tokenBucket is a token bucket refilling at a fixed rate per minute. Its
+// capacity is a second worth of requests so bursts stay small.
+type tokenBucket struct {
+	mu       sync.Mutex
+	tokens   float64
+	capacity float64
+	rate     float64
+	last     time.Time
+}
+
+func newTokenBucket(requestsPerMinute int) *tokenBucket {
+	rate := float64(requestsPerMinute) / 60
+	capacity := max(1, rate)
+
+	return &tokenBucket{
+		tokens:   capacity,
+		capacity: capacity,
+		rate:     rate,
+		last:     time.Now(),
+	}
+}
+
+// wait takes a token from the bucket, blocking until it is available or the
+// context is done. Tokens are reserved in order, so waiting callers are
+// served first come, first served.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	delay := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	b.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Delaying Pterodactyl API request to respect the rate limit", map[string]interface{}{
+		"wait": delay.String(),
+	})
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		// Hand the reserved token back to the callers still waiting.
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
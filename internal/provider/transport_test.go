@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// statusTransport answers every request with the next of its status codes
+// and body, an empty JSON object by default.
+type statusTransport struct {
+	statuses []int
+	body     string
+	requests int
+}
+
+func (rt *statusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	status := rt.statuses[rt.requests]
+	rt.requests++
+
+	body := rt.body
+	if body == "" {
+		body = "{}"
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestProviderConfigureTransport(t *testing.T) {
+	ctx := context.Background()
+
+	transport := &statusTransport{statuses: []int{http.StatusServiceUnavailable, http.StatusOK}}
+	p := &pterodactylProvider{version: "test", transport: transport}
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	values["host"] = tftypes.NewValue(tftypes.String, "https://panel.example.com")
+	values["api_key"] = tftypes.NewValue(tftypes.String, "ptla_test")
+	values["retry_min_wait"] = tftypes.NewValue(tftypes.String, "1ms")
+	values["retry_max_wait"] = tftypes.NewValue(tftypes.String, "1ms")
+	values["requests_per_minute"] = tftypes.NewValue(tftypes.Number, 0)
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configuring the provider: %v", resp.Diagnostics)
+	}
+
+	// The transport of the provider is wrapped by the retry transport, so
+	// the request is retried after the 503 response.
+	client := resp.ResourceData.(*pterodactylClients).Application
+	res, err := client.HTTPClient.Get(client.HostURL + "/api/application/users")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("status is %d, expected %d", res.StatusCode, http.StatusOK)
+	}
+	if transport.requests != 2 {
+		t.Errorf("the transport received %d requests, expected 2", transport.requests)
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		status int
+		want   bool
+	}{
+		"rate limited GET":  {http.MethodGet, http.StatusTooManyRequests, true},
+		"rate limited POST": {http.MethodPost, http.StatusTooManyRequests, true},
+		"bad gateway GET":   {http.MethodGet, http.StatusBadGateway, true},
+		// A proxy may answer after the panel created the object already.
+		"bad gateway POST":     {http.MethodPost, http.StatusBadGateway, false},
+		"unavailable DELETE":   {http.MethodDelete, http.StatusServiceUnavailable, true},
+		"unavailable PATCH":    {http.MethodPatch, http.StatusServiceUnavailable, false},
+		"gateway timeout PUT":  {http.MethodPut, http.StatusGatewayTimeout, true},
+		"gateway timeout POST": {http.MethodPost, http.StatusGatewayTimeout, false},
+		"server error":         {http.MethodGet, http.StatusInternalServerError, false},
+		"validation error":     {http.MethodPost, http.StatusUnprocessableEntity, false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(testCase.method, "https://panel.example.com/api/application/servers", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := shouldRetry(req, &http.Response{StatusCode: testCase.status}, nil)
+			if got != testCase.want {
+				t.Errorf("shouldRetry is %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
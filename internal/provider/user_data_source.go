@@ -156,17 +156,19 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	client := withContext(ctx, d.client)
+
 	// Fetch the user from the API based on the provided attribute
 	var user pterodactyl.User
 	var err error
 	if !state.ID.IsNull() {
-		user, err = d.client.GetUser(state.ID.ValueInt32())
+		user, err = client.GetUser(state.ID.ValueInt32())
 	} else if !state.Username.IsNull() {
-		user, err = d.client.GetUserUsername(state.Username.ValueString())
+		user, err = client.GetUserUsername(state.Username.ValueString())
 	} else if !state.Email.IsNull() {
-		user, err = d.client.GetUserEmail(state.Email.ValueString())
+		user, err = client.GetUserEmail(state.Email.ValueString())
 	} else if !state.ExternalID.IsNull() {
-		user, err = d.client.GetUserExternalID(state.ExternalID.ValueString())
+		user, err = client.GetUserExternalID(state.ExternalID.ValueString())
 	} else {
 		resp.Diagnostics.AddError(
 			"Missing Attribute",
@@ -215,15 +217,15 @@ func (d *userDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*pterodactyl.Client)
+	clients, ok := req.ProviderData.(*pterodactylClients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *pterodactyl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = clients.Application
 }
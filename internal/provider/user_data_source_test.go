@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUserDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewUserDataSource(), "user_data_source_read", map[string]tftypes.Value{
+		"username": tftypes.NewValue(tftypes.String, "terraformer"),
+	})
+
+	var user userDataSourceModel
+	replayStateGet(t, state, &user)
+
+	replayCheck(t, "id", user.ID, types.Int32Value(7))
+	replayCheck(t, "external_id", user.ExternalID, types.StringValue(""))
+	replayCheck(t, "uuid", user.UUID, types.StringValue("5d1e4c3a-2b8f-4a6e-9c0d-7f3e2a1b9c8d"))
+	replayCheck(t, "email", user.Email, types.StringValue("terraformer@example.com"))
+	replayCheck(t, "first_name", user.FirstName, types.StringValue("Terra"))
+	replayCheck(t, "last_name", user.LastName, types.StringValue("Former"))
+	replayCheck(t, "language", user.Language, types.StringValue("en"))
+	replayCheck(t, "root_admin", user.RootAdmin, types.BoolValue(false))
+	replayCheck(t, "is_2fa", user.Is2FA, types.BoolValue(false))
+	replayCheck(t, "created_at", user.CreatedAt, types.StringValue("2024-01-05T08:00:00Z"))
+	replayCheck(t, "updated_at", user.UpdatedAt, types.StringValue("2024-04-18T12:30:45Z"))
+}
@@ -7,12 +7,18 @@ import (
 	"time"
 
 	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -34,13 +40,31 @@ type userResource struct {
 
 // userResourceModel maps the resource schema data.
 type userResourceModel struct {
-	ID        types.Int32  `tfsdk:"id"`
-	Username  types.String `tfsdk:"username"`
-	Email     types.String `tfsdk:"email"`
-	FirstName types.String `tfsdk:"first_name"`
-	LastName  types.String `tfsdk:"last_name"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID              types.Int32    `tfsdk:"id"`
+	Username        types.String   `tfsdk:"username"`
+	Email           types.String   `tfsdk:"email"`
+	FirstName       types.String   `tfsdk:"first_name"`
+	LastName        types.String   `tfsdk:"last_name"`
+	RootAdmin       types.Bool     `tfsdk:"root_admin"`
+	Language        types.String   `tfsdk:"language"`
+	ExternalID      types.String   `tfsdk:"external_id"`
+	Password        types.String   `tfsdk:"password"`
+	PasswordVersion types.String   `tfsdk:"password_version"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	UpdatedAt       types.String   `tfsdk:"updated_at"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// userAPIFields maps the fields of panel validation errors to the user schema.
+var userAPIFields = rootAPIFields("username", "email", "first_name", "last_name", "root_admin", "language", "external_id", "password")
+
+// userImportIDs are the import IDs of users, plain import IDs are usernames.
+var userImportIDs = importIDLookup{
+	Object:     "user",
+	Endpoint:   "/api/application/users",
+	DefaultKey: "username",
+	Keys:       []string{"username", "email", "external_id", "uuid"},
+	FoldKeys:   []string{"email"},
 }
 
 // Metadata returns the resource type name.
@@ -49,7 +73,7 @@ func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 // Schema defines the schema for the resource.
-func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The Pterodactyl user resource allows Terraform to manage users in the Pterodactyl Panel API.",
 		Attributes: map[string]schema.Attribute{
@@ -76,6 +100,48 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "The last name of the user.",
 				Required:    true,
 			},
+			"root_admin": schema.BoolAttribute{
+				Description: "Is the user an administrator of the panel? Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"language": schema.StringAttribute{
+				Description: "The language of the user, e.g. en. Defaults to the language of the panel.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(2),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Description: "The ID of the user in an external system, e.g. a SSO directory.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 191),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the user, write-only so it is never stored in the plan or state, which requires Terraform 1.11 or later. " +
+					"It is sent when the user is created and whenever password_version changes. Users created without a password receive an email to set one.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+				},
+			},
+			"password_version": schema.StringAttribute{
+				Description: "Any value, change it to send the password again, e.g. after changing it. " +
+					"The panel never returns the password, so changes made outside of Terraform are not detected.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Description: "The creation date of the user.",
 				Computed:    true,
@@ -88,6 +154,14 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -101,26 +175,36 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	// Create partial user
-	partialUser := pterodactyl.PartialUser{
-		Username:  plan.Username.ValueString(),
-		Email:     plan.Email.ValueString(),
-		FirstName: plan.FirstName.ValueString(),
-		LastName:  plan.LastName.ValueString(),
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// The password is write-only, it is only part of the configuration
+	var password types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Create new user
-	user, err := r.client.CreateUser(partialUser)
+	user, err := createUser(ctx, r.client, plan.toAPI(password.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics, userAPIFields, err,
 			"Error creating user",
-			"Could not create user, unexpected error: "+err.Error(),
+			contextErrorDetail(ctx, "creating the user", "Could not create user, unexpected error: "+err.Error()),
 		)
 		return
 	}
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.Int32Value(user.ID)
+	plan.Language = types.StringValue(user.Language)
 	plan.CreatedAt = types.StringValue(user.CreatedAt.Format(time.RFC3339))
 	plan.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
 
@@ -142,22 +226,36 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client := withContext(ctx, r.client)
+
 	// Get refreshed user value from Pterodactyl
-	user, err := r.client.GetUser(state.ID.ValueInt32())
+	user, err := client.GetUser(state.ID.ValueInt32())
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Pterodactyl user not found, removing it from state", map[string]interface{}{
+			"id": state.ID.ValueInt32(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Pterodactyl User",
-			"Could not read Pterodactyl user ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error(),
+			contextErrorDetail(ctx, "reading the user", "Could not read Pterodactyl user ID "+strconv.FormatInt(int64(state.ID.ValueInt32()), 10)+": "+err.Error()),
 		)
 		return
 	}
 
-	// Overwrite items with refreshed state
-	state.Email = types.StringValue(user.Email)
-	state.FirstName = types.StringValue(user.FirstName)
-	state.LastName = types.StringValue(user.LastName)
-	state.UpdatedAt = types.StringValue(user.UpdatedAt.Format(time.RFC3339))
-	state.CreatedAt = types.StringValue(user.CreatedAt.Format(time.RFC3339))
+	// Overwrite items with refreshed state, the password is never returned
+	state.fromAPI(user)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -176,20 +274,39 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// Create partial user
-	var partialUser pterodactyl.PartialUser = pterodactyl.PartialUser{
-		Username:  plan.Username.ValueString(),
-		Email:     plan.Email.ValueString(),
-		FirstName: plan.FirstName.ValueString(),
-		LastName:  plan.LastName.ValueString(),
+	var state userResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only send the write-only password when its version changed, the panel
+	// keeps the current one otherwise
+	var password types.String
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Update existing user
-	user, err := r.client.UpdateUser(plan.ID.ValueInt32(), partialUser)
+	user, err := updateUser(ctx, r.client, plan.ID.ValueInt32(), plan.toAPI(password.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics, userAPIFields, err,
 			"Error Updating Pterodactyl User",
-			"Could not update user, unexpected error: "+err.Error(),
+			contextErrorDetail(ctx, "updating the user", "Could not update user, unexpected error: "+err.Error()),
 		)
 		return
 	}
@@ -198,6 +315,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	plan.Email = types.StringValue(user.Email)
 	plan.FirstName = types.StringValue(user.FirstName)
 	plan.LastName = types.StringValue(user.LastName)
+	plan.Language = types.StringValue(user.Language)
 	plan.UpdatedAt = types.StringValue(user.UpdatedAt.Format(time.RFC3339))
 
 	diags = resp.State.Set(ctx, plan)
@@ -217,12 +335,23 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client := withContext(ctx, r.client)
+
 	// Delete existing user
-	err := r.client.DeleteUser(state.ID.ValueInt32())
-	if err != nil {
+	err := client.DeleteUser(state.ID.ValueInt32())
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Pterodactyl User",
-			"Could not delete user, unexpected error: "+err.Error(),
+			contextErrorDetail(ctx, "deleting the user", "Could not delete user, unexpected error: "+err.Error()),
 		)
 		return
 	}
@@ -236,24 +365,29 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*pterodactyl.Client)
+	clients, ok := req.ProviderData.(*pterodactylClients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *pterodactyl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = clients.Application
 }
 
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	username := req.ID
+	userID, ok := userImportIDs.resolve(ctx, r.client, &resp.Diagnostics, req.ID)
+	if !ok {
+		return
+	}
+
+	client := withContext(ctx, r.client)
 
-	user, err := r.client.GetUserUsername(username)
+	user, err := client.GetUser(userID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Importing Pterodactyl User",
@@ -262,15 +396,14 @@ func (r *userResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 
-	// Map response body to schema and populate Computed attribute values
-	state := userResourceModel{
-		ID:        types.Int32Value(user.ID),
-		Username:  types.StringValue(user.Username),
-		Email:     types.StringValue(user.Email),
-		FirstName: types.StringValue(user.FirstName),
-		LastName:  types.StringValue(user.LastName),
-		CreatedAt: types.StringValue(user.CreatedAt.Format(time.RFC3339)),
-		UpdatedAt: types.StringValue(user.UpdatedAt.Format(time.RFC3339)),
+	// Map response body to schema and populate Computed attribute values,
+	// the password is not returned by the panel and never stored
+	var state userResourceModel
+	state.fromAPI(user)
+
+	state.Timeouts = importTimeouts(ctx, resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Set state to fully populated data
@@ -280,3 +413,35 @@ func (r *userResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 }
+
+// fromAPI maps the panel data of a user to the model.
+func (m *userResourceModel) fromAPI(user pterodactyl.User) {
+	m.ID = types.Int32Value(user.ID)
+	m.Username = types.StringValue(user.Username)
+	m.Email = types.StringValue(user.Email)
+	m.FirstName = types.StringValue(user.FirstName)
+	m.LastName = types.StringValue(user.LastName)
+	m.RootAdmin = types.BoolValue(user.RootAdmin)
+	m.Language = types.StringValue(user.Language)
+	m.ExternalID = types.StringNull()
+	if user.ExternalID != "" {
+		m.ExternalID = types.StringValue(user.ExternalID)
+	}
+	m.CreatedAt = types.StringValue(user.CreatedAt.Format(time.RFC3339))
+	m.UpdatedAt = types.StringValue(user.UpdatedAt.Format(time.RFC3339))
+}
+
+// toAPI maps the model to the request body of the panel, an empty password
+// keeps the current password of the user.
+func (m userResourceModel) toAPI(password string) apiPartialUser {
+	return apiPartialUser{
+		ExternalID: m.ExternalID.ValueStringPointer(),
+		Username:   m.Username.ValueString(),
+		Email:      m.Email.ValueString(),
+		FirstName:  m.FirstName.ValueString(),
+		LastName:   m.LastName.ValueString(),
+		Language:   m.Language.ValueString(),
+		RootAdmin:  m.RootAdmin.ValueBool(),
+		Password:   password,
+	}
+}
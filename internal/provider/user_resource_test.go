@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccUserResource(t *testing.T) {
+	panel := newFakePanel(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             panel.testAccCheckDestroyed,
+		// The password is a write-only attribute
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(panel) + testAccUserResourceConfig("terraformer", "terraformer@example.com", "Terra"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pterodactyl_user.test", "username", "terraformer"),
+					resource.TestCheckResourceAttr("pterodactyl_user.test", "email", "terraformer@example.com"),
+					resource.TestCheckResourceAttr("pterodactyl_user.test", "first_name", "Terra"),
+					resource.TestCheckResourceAttr("pterodactyl_user.test", "last_name", "Former"),
+					resource.TestCheckResourceAttr("pterodactyl_user.test", "root_admin", "true"),
+					resource.TestCheckResourceAttr("pterodactyl_user.test", "language", "en"),
+					resource.TestCheckResourceAttr("pterodactyl_user.test", "external_id", "sso-1042"),
+					resource.TestCheckResourceAttrSet("pterodactyl_user.test", "id"),
+					resource.TestCheckResourceAttrSet("pterodactyl_user.test", "created_at"),
+					// The password is write-only and never stored
+					resource.TestCheckNoResourceAttr("pterodactyl_user.test", "password"),
+					testAccCheckUserPassword(panel, "terraformer", "correct-horse-battery"),
+				),
+			},
+			// ImportState testing, users are imported by username
+			{
+				ResourceName:                         "pterodactyl_user.test",
+				ImportState:                          true,
+				ImportStateId:                        "terraformer",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "username",
+				// The panel never returns the password nor its version
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			// ImportState testing by the email
+			{
+				ResourceName:            "pterodactyl_user.test",
+				ImportState:             true,
+				ImportStateId:           "email:terraformer@example.com",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(panel) + testAccUserResourceConfig("terraformer", "terraformer@example.org", "Terry"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pterodactyl_user.test", "email", "terraformer@example.org"),
+					resource.TestCheckResourceAttr("pterodactyl_user.test", "first_name", "Terry"),
+					// Delete the user in the panel for the next step
+					testAccDeleteInPanel(panel, panel.users, "pterodactyl_user.test"),
+				),
+				// The refresh after the checks finds the user gone
+				ExpectNonEmptyPlan: true,
+			},
+			// Re-create testing after the user has been deleted in the panel
+			{
+				Config: testAccProviderConfig(panel) + testAccUserResourceConfig("terraformer", "terraformer@example.org", "Terry"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pterodactyl_user.test", "username", "terraformer"),
+				),
+			},
+			// Validation errors of the panel are reported on the attribute
+			{
+				Config:      testAccProviderConfig(panel) + testAccUserResourceConfig("terraformer", "not-an-email", "Terry"),
+				ExpectError: regexp.MustCompile(`The\s+email\s+must\s+be\s+a\s+valid\s+email\s+address`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUserResourceConfig(username, email, firstName string) string {
+	return fmt.Sprintf(`
+resource "pterodactyl_user" "test" {
+  username   = %[1]q
+  email      = %[2]q
+  first_name = %[3]q
+  last_name  = "Former"
+
+  root_admin       = true
+  external_id      = "sso-1042"
+  password         = "correct-horse-battery"
+  password_version = "1"
+}
+`, username, email, firstName)
+}
+
+// testAccCheckUserPassword checks the password the fake panel received for a user.
+func testAccCheckUserPassword(p *fakePanel, username, password string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		for id, user := range p.users {
+			if user.Username == username && p.passwords[id] != password {
+				return fmt.Errorf("user %s has password %q, expected %q", username, p.passwords[id], password)
+			}
+		}
+		return nil
+	}
+}
+
+func TestUserResourceReadRefreshesUsername(t *testing.T) {
+	ctx := context.Background()
+
+	r := &userResource{client: &pterodactyl.Client{
+		HostURL: "https://panel.example.com",
+		Token:   "ptla_test",
+		HTTPClient: &http.Client{Transport: &statusTransport{
+			statuses: []int{http.StatusOK},
+			body: `{"object": "user", "attributes": {"id": 7, "uuid": "5e2a9c1b-0d3f-4a8e-9b7c-6d5e4f3a2b1c",
+				"username": "renamed", "email": "terraformer@example.com", "first_name": "Terra", "last_name": "Former",
+				"created_at": "2021-09-14T19:22:03+00:00", "updated_at": "2021-09-15T08:00:00+00:00"}}`,
+		}},
+	}}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.Number, 7)
+	values["username"] = tftypes.NewValue(tftypes.String, "terraformer")
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading the user: %v", resp.Diagnostics)
+	}
+
+	// A user renamed in the panel shows up as a change of its username.
+	var username types.String
+	resp.State.GetAttribute(ctx, path.Root("username"), &username)
+	if !username.Equal(types.StringValue("renamed")) {
+		t.Errorf("username is %s, expected \"renamed\"", username)
+	}
+}
+
+func TestUserResourceRead(t *testing.T) {
+	testCases := map[string]struct {
+		fixture    string
+		firstName  string
+		lastName   string
+		rootAdmin  bool
+		language   string
+		externalID types.String
+		createdAt  string
+		updatedAt  string
+	}{
+		"pterodactyl": {
+			fixture:    "user_resource_read",
+			firstName:  "Terra",
+			lastName:   "Former",
+			rootAdmin:  true,
+			language:   "de",
+			externalID: types.StringValue("sso-1042"),
+			createdAt:  "2024-01-05T08:00:00Z",
+			updatedAt:  "2024-04-18T12:30:45Z",
+		},
+		// Pelican does not return the first and last name of users anymore.
+		"pelican": {
+			fixture:    "user_resource_read_pelican",
+			language:   "en",
+			externalID: types.StringNull(),
+			createdAt:  "2024-06-05T08:00:00Z",
+			updatedAt:  "2024-06-18T12:30:45Z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := replayResourceRead(t, NewUserResource(), testCase.fixture, map[string]tftypes.Value{
+				"id":               tftypes.NewValue(tftypes.Number, 7),
+				"password_version": tftypes.NewValue(tftypes.String, "1"),
+			})
+
+			var user userResourceModel
+			replayStateGet(t, state, &user)
+
+			replayCheck(t, "id", user.ID, types.Int32Value(7))
+			replayCheck(t, "username", user.Username, types.StringValue("terraformer"))
+			replayCheck(t, "email", user.Email, types.StringValue("terraformer@example.com"))
+			replayCheck(t, "first_name", user.FirstName, types.StringValue(testCase.firstName))
+			replayCheck(t, "last_name", user.LastName, types.StringValue(testCase.lastName))
+			replayCheck(t, "root_admin", user.RootAdmin, types.BoolValue(testCase.rootAdmin))
+			replayCheck(t, "language", user.Language, types.StringValue(testCase.language))
+			replayCheck(t, "external_id", user.ExternalID, testCase.externalID)
+			// The panel never returns the password, its version is kept.
+			replayCheck(t, "password_version", user.PasswordVersion, types.StringValue("1"))
+			replayCheck(t, "created_at", user.CreatedAt, types.StringValue(testCase.createdAt))
+			replayCheck(t, "updated_at", user.UpdatedAt, types.StringValue(testCase.updatedAt))
+		})
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/Luiggi33/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -29,7 +31,12 @@ type usersDataSource struct {
 
 // usersDataSourceModel maps the data source schema data.
 type usersDataSourceModel struct {
-	Users []User `tfsdk:"users"`
+	Email      types.String `tfsdk:"email"`
+	Username   types.String `tfsdk:"username"`
+	UUID       types.String `tfsdk:"uuid"`
+	ExternalID types.String `tfsdk:"external_id"`
+	Sort       types.String `tfsdk:"sort"`
+	Users      []User       `tfsdk:"users"`
 }
 
 // User schema data.
@@ -58,6 +65,41 @@ func (d *usersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 	resp.Schema = schema.Schema{
 		Description: "The Pterodactyl users data source allows Terraform to read user data from the Pterodactyl Panel API.",
 		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Description: "Only return the user with this email, compared regardless of case.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "Only return the user with this username.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "Only return the user with this UUID.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Description: "Only return the users with this external ID.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Description: "The attribute the users are sorted by, id or uuid, prefixed with - to sort in descending order, e.g. -id. Defaults to the order of the panel.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(sortValues("id", "uuid")...),
+				},
+			},
 			"users": schema.ListNestedAttribute{
 				Description: "The list of users.",
 				Computed:    true,
@@ -122,7 +164,21 @@ func (d *usersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state usersDataSourceModel
 
-	users, err := d.client.GetUsers()
+	// Get the filters from the request
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := listQuery(map[string]types.String{
+		"email":       state.Email,
+		"username":    state.Username,
+		"uuid":        state.UUID,
+		"external_id": state.ExternalID,
+	}, state.Sort)
+
+	users, err := getUsers(ctx, d.client, query)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Pterodactyl Users",
@@ -132,7 +188,15 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Map response body to model
+	state.Users = make([]User, 0, len(users))
 	for _, user := range users {
+		if !matchesFilterFold(state.Email, user.Email) ||
+			!matchesFilter(state.Username, user.Username) ||
+			!matchesFilter(state.UUID, user.UUID) ||
+			!matchesFilter(state.ExternalID, user.ExternalID) {
+			continue
+		}
+
 		userState := User{
 			ID:         types.Int32Value(user.ID),
 			ExternalID: types.StringValue(user.ExternalID),
@@ -152,7 +216,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -167,15 +231,15 @@ func (d *usersDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*pterodactyl.Client)
+	clients, ok := req.ProviderData.(*pterodactylClients)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *pterodactyl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *pterodactylClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = clients.Application
 }
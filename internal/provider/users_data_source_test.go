@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUsersDataSourceRead(t *testing.T) {
+	state := replayDataSourceRead(t, NewUsersDataSource(), "users_data_source_read", nil)
+
+	var users usersDataSourceModel
+	replayStateGet(t, state, &users)
+
+	if len(users.Users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users.Users))
+	}
+
+	replayCheck(t, "users.0.username", users.Users[0].Username, types.StringValue("admin"))
+	replayCheck(t, "users.0.external_id", users.Users[0].ExternalID, types.StringValue("sso-1"))
+	replayCheck(t, "users.0.root_admin", users.Users[0].RootAdmin, types.BoolValue(true))
+	replayCheck(t, "users.0.is_2fa", users.Users[0].Is2FA, types.BoolValue(true))
+	replayCheck(t, "users.0.created_at", users.Users[0].CreatedAt, types.StringValue("2021-03-02T13:37:00Z"))
+	replayCheck(t, "users.1.id", users.Users[1].ID, types.Int32Value(7))
+	replayCheck(t, "users.1.username", users.Users[1].Username, types.StringValue("terraformer"))
+	replayCheck(t, "users.1.updated_at", users.Users[1].UpdatedAt, types.StringValue("2021-09-14T19:22:03Z"))
+}
+
+func TestUsersDataSourceReadFiltered(t *testing.T) {
+	state := replayDataSourceRead(t, NewUsersDataSource(), "users_data_source_read_filtered", map[string]tftypes.Value{
+		"username": tftypes.NewValue(tftypes.String, "terraformer"),
+		"sort":     tftypes.NewValue(tftypes.String, "-id"),
+	})
+
+	var users usersDataSourceModel
+	replayStateGet(t, state, &users)
+
+	// The panel also returns terraformer-ci and terraformer2 for
+	// filter[username], the exact match is on the second page.
+	if len(users.Users) != 1 {
+		t.Fatalf("expected 1 user, got %d", len(users.Users))
+	}
+
+	replayCheck(t, "users.0.id", users.Users[0].ID, types.Int32Value(7))
+	replayCheck(t, "users.0.username", users.Users[0].Username, types.StringValue("terraformer"))
+	replayCheck(t, "username", users.Username, types.StringValue("terraformer"))
+}
+
+func TestUsersDataSourceReadEmail(t *testing.T) {
+	state := replayDataSourceRead(t, NewUsersDataSource(), "users_data_source_read_email", map[string]tftypes.Value{
+		"email": tftypes.NewValue(tftypes.String, "Terraformer@Example.com"),
+	})
+
+	var users usersDataSourceModel
+	replayStateGet(t, state, &users)
+
+	// Emails are compared regardless of case, but still exactly.
+	if len(users.Users) != 1 {
+		t.Fatalf("expected 1 user, got %d", len(users.Users))
+	}
+
+	replayCheck(t, "users.0.id", users.Users[0].ID, types.Int32Value(7))
+	replayCheck(t, "users.0.email", users.Users[0].Email, types.StringValue("terraformer@example.com"))
+}
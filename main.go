@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
@@ -30,8 +32,17 @@ func main() {
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %[1]s [-debug]\n       %[1]s export [-dir directory]\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
+	if flag.Arg(0) == "export" {
+		export(flag.Args()[1:])
+		return
+	}
+
 	opts := providerserver.ServeOpts{
 		Address: "registry.terraform.io/Luiggi33/pterodactyl",
 		Debug:   debug,
@@ -43,3 +54,31 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// export writes the locations, nodes and users of the panel configured by
+// the PTERODACTYL_* environment variables as Terraform configuration with
+// import blocks, to adopt an existing panel.
+func export(args []string) {
+	var dir string
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.StringVar(&dir, "dir", ".", "the directory to write the configuration to, existing files are never overwritten and nothing is written when one exists")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [-dir directory]\n\n"+
+			"Writes the locations, nodes and users of the panel as Terraform configuration with import blocks.\n"+
+			"The panel is configured by the PTERODACTYL_* environment variables of the provider.\n\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	files, diags := provider.Export(context.Background(), version, dir)
+	for _, diagnostic := range diags {
+		fmt.Fprintf(os.Stderr, "%s: %s\n\n%s\n\n", diagnostic.Severity(), diagnostic.Summary(), diagnostic.Detail())
+	}
+	for _, file := range files {
+		fmt.Println("Wrote " + file)
+	}
+	if diags.HasError() {
+		os.Exit(1)
+	}
+}